@@ -6,6 +6,7 @@ package acctest
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -303,6 +304,11 @@ var (
 	Pi_capture_cloud_storage_region     string
 )
 
+var (
+	Pi_replicate_target_cloud_instance_id string
+	Pi_replicate_target_zone              string
+)
+
 var ISDelegegatedVPC string
 
 // For Image
@@ -1483,6 +1489,17 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable PI_CAPTURE_CLOUD_STORAGE_REGION for testing Pi_capture_cloud_storage_region resource else it is set to default value 'us-south'")
 	}
 
+	Pi_replicate_target_cloud_instance_id = os.Getenv("PI_REPLICATE_TARGET_CLOUD_INSTANCE_ID")
+	if Pi_replicate_target_cloud_instance_id == "" {
+		Pi_replicate_target_cloud_instance_id = Pi_cloud_instance_id
+		fmt.Println("[INFO] Set the environment variable PI_REPLICATE_TARGET_CLOUD_INSTANCE_ID for testing ibm_pi_instance_replicate action else it is set to the value of PI_CLOUDINSTANCE_ID")
+	}
+
+	Pi_replicate_target_zone = os.Getenv("PI_REPLICATE_TARGET_ZONE")
+	if Pi_replicate_target_zone == "" {
+		fmt.Println("[INFO] Set the environment variable PI_REPLICATE_TARGET_ZONE for testing ibm_pi_instance_replicate action else the zone of the provider is used")
+	}
+
 	Pi_shared_processor_pool_id = os.Getenv("PI_SHARED_PROCESSOR_POOL_ID")
 	if Pi_shared_processor_pool_id == "" {
 		Pi_shared_processor_pool_id = "tf-pi-shared-processor-pool"
@@ -2330,14 +2347,26 @@ var (
 // This is required for testing Framework-only features like Actions.
 // The mux setup mirrors the production configuration in main.go.
 func TestAccProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return TestAccProtoV6ProviderFactoriesWithHTTPTransport(nil)
+}
+
+// TestAccProtoV6ProviderFactoriesWithHTTPTransport returns the provider
+// factories of TestAccProtoV6ProviderFactories, whose IBM Cloud clients send
+// their requests through the given transport, such as a unittest.Recorder.
+func TestAccProtoV6ProviderFactoriesWithHTTPTransport(transport http.RoundTripper) map[string]func() (tfprotov6.ProviderServer, error) {
 	return map[string]func() (tfprotov6.ProviderServer, error){
 		"ibm": func() (tfprotov6.ProviderServer, error) {
 			ctx := context.Background()
 
+			sdkProvider := provider.Provider()
+			if transport != nil {
+				sdkProvider = provider.ProviderWithHTTPTransport(transport)
+			}
+
 			// Upgrade SDKv2 provider to protocol v6
 			upgradedSdkProvider, err := tf5to6server.UpgradeServer(
 				ctx,
-				provider.Provider().GRPCProvider,
+				provider.GRPCProviderWithPlanWarnings(sdkProvider),
 			)
 			if err != nil {
 				return nil, err
@@ -2346,7 +2375,7 @@ func TestAccProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServ
 			// Create framework provider server
 			// New() returns a factory function, so we call it to get the provider
 			frameworkProviderServer := providerserver.NewProtocol6(
-				provider_framework.New("test")(),
+				provider_framework.NewWithHTTPTransport("test", transport)(),
 			)
 
 			// Create mux server combining both providers
@@ -2744,6 +2773,15 @@ func TestAccPreCheckPartnerCenterSell(t *testing.T) {
 	}
 }
 
+// TestAccProvidersWithHTTPTransport returns the providers of TestAccProviders,
+// whose IBM Cloud clients send their requests through the given transport,
+// such as a unittest.Recorder.
+func TestAccProvidersWithHTTPTransport(transport http.RoundTripper) map[string]*schema.Provider {
+	return map[string]*schema.Provider{
+		ProviderName: provider.ProviderWithHTTPTransport(transport),
+	}
+}
+
 func TestAccProviderFactories() map[string]func() (*schema.Provider, error) {
 	return map[string]func() (*schema.Provider, error){
 		ProviderName:          func() (*schema.Provider, error) { return provider.Provider(), nil },
@@ -5,17 +5,15 @@ package conns
 
 import (
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"log"
 	"net"
 	gohttp "net/http"
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
@@ -98,6 +96,7 @@ import (
 	"github.com/IBM/vpc-go-sdk/common"
 	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/apache/openwhisk-client-go/whisk"
+	httptransport "github.com/go-openapi/runtime/client"
 	jwt "github.com/golang-jwt/jwt/v5"
 	slsession "github.com/softlayer/softlayer-go/session"
 
@@ -222,6 +221,24 @@ type Config struct {
 	Visibility          string
 	PrivateEndpointType string
 	EndpointsFile       string
+
+	// RequestsPerSecond limits the API calls sent to each service endpoint;
+	// unlimited if zero.
+	RequestsPerSecond float64
+	// MaxInFlightRequests limits the API calls in progress at the same time;
+	// unlimited if zero.
+	MaxInFlightRequests int
+	// MaxRetryDelay is the longest wait between two attempts of a rate
+	// limited API call. DefaultMaxRetryDelay is used if zero.
+	MaxRetryDelay time.Duration
+
+	// HTTPTransport, when set, is used by the HTTP clients of the IBM Cloud
+	// services instead of their default transport. The unit tests use it to
+	// record and replay API calls.
+	HTTPTransport gohttp.RoundTripper
+
+	// rateLimiter is shared by the HTTP clients of the session.
+	rateLimiter *rateLimiter
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -351,6 +368,17 @@ type ClientSession interface {
 type clientSession struct {
 	session *Session
 
+	// Service clients are built on first use from the state below, so a
+	// misconfigured or unreachable service does not slow down or break
+	// provider configuration for every other service.
+	config        *Config
+	fileMap       map[string]interface{}
+	authenticator core.Authenticator
+	iamURL        string
+	cisEndPoint   string
+	configuredMu  sync.Mutex
+	configured    map[string]*sync.Once
+
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
 
@@ -746,65 +774,92 @@ type clientSession struct {
 }
 
 // Usage Reports
-func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+func (session *clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+	session.configure("UsageReports", session.configureUsageReports)
 	return session.usageReportsClient, session.usageReportsClientErr
 }
 
-func (session clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+func (session *clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+	session.configure("PartnerCenterSell", session.configurePartnerCenterSell)
 	return session.partnerCenterSellClient, session.partnerCenterSellClientErr
 }
 
 // Configuration Aggregator
-func (session clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+func (session *clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+	session.configure("ConfigurationAggregator", session.configureConfigurationAggregator)
 	return session.configurationAggregatorClient, session.configurationAggregatorClientErr
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.configure("AppID", session.configureAppID)
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.configure("CatalogManagement", session.configureCatalogManagement)
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.configure("AccountV2", sess.configureAccountV2)
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.configure("AccountV1", sess.configureAccountV1)
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
+// configure runs configureFunc once per service client group. When no IBM Cloud
+// credentials were provided the errors preset by ClientSession are kept as is.
+func (sess *clientSession) configure(name string, configureFunc func()) {
+	if sess.session == nil || sess.session.BluemixSession == nil {
+		return
+	}
+	sess.configuredMu.Lock()
+	once, ok := sess.configured[name]
+	if !ok {
+		once = &sync.Once{}
+		sess.configured[name] = once
+	}
+	sess.configuredMu.Unlock()
+	once.Do(configureFunc)
+}
+
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.configure("Container", sess.configureContainer)
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.configure("VpcContainer", sess.configureVpcContainer)
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.configure("ContainerRegistry", session.configureContainerRegistry)
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.configure("Schematics", sess.configureSchematics)
 	if sess.schematicsClientErr != nil {
 		return sess.schematicsClient, sess.schematicsClientErr
 	}
@@ -813,122 +868,146 @@ func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.configure("Function", sess.configureFunction)
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.configure("GlobalSearch", sess.configureGlobalSearch)
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.configure("GlobalTagging", sess.configureGlobalTagging)
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.configure("GlobalTaggingV1", sess.configureGlobalTaggingV1)
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // GlobalSearchAPIV2 provides Platform-go Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+func (sess *clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+	sess.configure("GlobalSearchV2", sess.configureGlobalSearchV2)
 	return sess.globalSearchServiceAPIV2, sess.globalSearchConfigErrV2
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.configure("HpcsEndpoint", sess.configureHpcsEndpoint)
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.configure("Uko", session.configureUko)
 	return session.ukoClient, session.ukoClientErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.configure("UserManagement", sess.configureUserManagement)
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.configure("IAMPolicyManagement", sess.configureIAMPolicyManagement)
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.configure("IAMAccessGroups", sess.configureIAMAccessGroups)
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.configure("IBMCloudShell", session.configureIBMCloudShell)
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.configure("ICD", sess.configureICD)
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.configure("CloudDatabases", session.configureCloudDatabases)
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // IBM Db2 SaaS on Cloud REST API
-func (session clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+func (session *clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+	session.configure("Db2saas", session.configureDb2saas)
 	return session.db2saasClient, session.db2saasClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.configure("Mccp", sess.configureMccp)
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.configure("ResourceCatalog", sess.configureResourceCatalog)
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.configure("ResourceManagementV2", sess.configureResourceManagementV2)
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.configure("BluemixResourceController", sess.configureBluemixResourceController)
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.configure("BluemixResourceControllerV2", sess.configureBluemixResourceControllerV2)
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.configure("PushService", session.configurePushService)
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.configure("EventNotifications", session.configureEventNotifications)
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.configure("AppConfiguration", session.configureAppConfiguration)
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.configure("KeyProtect", sess.configureKeyProtect)
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.configure("KeyManagement", sess.configureKeyManagement)
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
@@ -947,7 +1026,7 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, sess.config.httpTransport())
 		if err != nil {
 			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
@@ -956,50 +1035,60 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.configure("Vpc", sess.configureVpc)
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+func (sess *clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+	sess.configure("Vpc", sess.configureVpc)
 	return sess.vpcBetaAPI, sess.vpcbetaErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.configure("Directlink", sess.configureDirectlink)
 	return sess.directlinkAPI, sess.directlinkErr
 }
 
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.configure("DirectlinkProvider", sess.configureDirectlinkProvider)
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
 
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.configure("CosConfig", sess.configureCosConfig)
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.configure("TransitGateway", sess.configureTransitGateway)
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.configure("IBMPI", sess.configureIBMPI)
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.configure("PrivateDNS", sess.configurePrivateDNS)
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.configure("FunctionIAMNamespace", sess.configureFunctionIAMNamespace)
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.configure("CisZones", sess.configureCisZones)
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -1007,7 +1096,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.configure("CisDNSRecords", sess.configureCisDNSRecords)
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -1015,7 +1105,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.configure("CisDNSRecordBulk", sess.configureCisDNSRecordBulk)
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -1023,7 +1114,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.configure("CisGLBPool", sess.configureCisGLBPool)
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -1031,7 +1123,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.configure("CisGLB", sess.configureCisGLB)
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -1039,7 +1132,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.configure("CisGLBHealthCheck", sess.configureCisGLBHealthCheck)
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -1047,7 +1141,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.configure("CisRL", sess.configureCisRL)
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -1055,7 +1150,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.configure("CisIP", sess.configureCisIP)
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -1063,7 +1159,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.configure("CisPageRule", sess.configureCisPageRule)
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -1071,7 +1168,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.configure("CisEdgeFunction", sess.configureCisEdgeFunction)
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -1079,7 +1177,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.configure("CisSSL", sess.configureCisSSL)
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -1087,17 +1186,20 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // DrAutomation Service
-func (session clientSession) DrAutomationServiceV1() (*drautomationservicev1.DrAutomationServiceV1, error) {
+func (session *clientSession) DrAutomationServiceV1() (*drautomationservicev1.DrAutomationServiceV1, error) {
+	session.configure("DrAutomationService", session.configureDrAutomationService)
 	return session.drAutomationServiceClient, session.drAutomationServiceClientErr
 }
 
 // PowerhaAutomation Service
-func (session clientSession) PowerhaAutomationServiceV1() (*powerhaautomationservicev1.PowerhaAutomationServiceV1, error) {
+func (session *clientSession) PowerhaAutomationServiceV1() (*powerhaautomationservicev1.PowerhaAutomationServiceV1, error) {
+	session.configure("PowerhaAutomationService", session.configurePowerhaAutomationService)
 	return session.powerhaAutomationServiceClient, session.powerhaAutomationServiceClientErr
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.configure("CisWAFPackage", sess.configureCisWAFPackage)
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -1105,7 +1207,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.configure("CisDomainSettings", sess.configureCisDomainSettings)
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -1113,7 +1216,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.configure("CisAlerts", sess.configureCisAlerts)
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -1121,7 +1225,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Rulesets
-func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+func (sess *clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+	sess.configure("CisRulesets", sess.configureCisRulesets)
 	if sess.cisRulesetsErr != nil {
 		return sess.cisRulesetsClient, sess.cisRulesetsErr
 	}
@@ -1129,7 +1234,8 @@ func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.configure("CisRouting", sess.configureCisRouting)
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -1137,7 +1243,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.configure("CisWAFGroup", sess.configureCisWAFGroup)
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -1145,7 +1252,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.configure("CisCache", sess.configureCisCache)
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -1153,7 +1261,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.configure("CisCustomPage", sess.configureCisCustomPage)
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -1161,7 +1270,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.configure("CisAccessRule", sess.configureCisAccessRule)
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -1169,7 +1279,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.configure("CisUARule", sess.configureCisUARule)
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -1177,7 +1288,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.configure("CisLockdown", sess.configureCisLockdown)
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1185,7 +1297,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.configure("CisRangeApp", sess.configureCisRangeApp)
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1193,7 +1306,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.configure("CisWAFRule", sess.configureCisWAFRule)
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1201,7 +1315,8 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // CIS Authenticated Origin Pull
-func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+func (sess *clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+	sess.configure("CisOriginAuth", sess.configureCisOriginAuth)
 	if sess.cisOriginAuthPullErr != nil {
 		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
 	}
@@ -1209,7 +1324,8 @@ func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOrig
 }
 
 // CIS Lists
-func (sess clientSession) CisListsSession() (*cislistsapiv1.ListsApiV1, error) {
+func (sess *clientSession) CisListsSession() (*cislistsapiv1.ListsApiV1, error) {
+	sess.configure("CisLists", sess.configureCisLists)
 	if sess.cisListsErr != nil {
 		return sess.cisListsClient, sess.cisListsErr
 	}
@@ -1217,60 +1333,72 @@ func (sess clientSession) CisListsSession() (*cislistsapiv1.ListsApiV1, error) {
 }
 
 // Account Management Session
-func (sess clientSession) AccountManagementV4() (*accountmanagementv4.AccountManagementV4, error) {
+func (sess *clientSession) AccountManagementV4() (*accountmanagementv4.AccountManagementV4, error) {
+	sess.configure("AccountManagement", sess.configureAccountManagement)
 	return sess.accountManagementAPI, sess.accountManagementErr
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.configure("IAMIdentity", sess.configureIAMIdentity)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.configure("ResourceManager", sess.configureResourceManager)
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.configure("EnterpriseManagement", session.configureEnterpriseManagement)
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.configure("ResourceController", sess.configureResourceController)
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
-func (session clientSession) BackupRecoveryV1() (*backuprecoveryv1.BackupRecoveryV1, error) {
+func (session *clientSession) BackupRecoveryV1() (*backuprecoveryv1.BackupRecoveryV1, error) {
+	session.configure("BackupRecovery", session.configureBackupRecovery)
 	return session.backupRecoveryClient, session.backupRecoveryClientErr
 }
 
-func (session clientSession) BackupRecoveryV1Connector() (*backuprecoveryv1.BackupRecoveryV1Connector, error) {
+func (session *clientSession) BackupRecoveryV1Connector() (*backuprecoveryv1.BackupRecoveryV1Connector, error) {
+	session.configure("BackupRecovery", session.configureBackupRecovery)
 	return session.backupRecoveryConnectorClient, session.backupRecoveryConnectorClientErr
 }
 
-func (session clientSession) BackupRecoveryManagerV1() (*backuprecoveryv1.BackupRecoveryManagementSreApiV1, error) {
+func (session *clientSession) BackupRecoveryManagerV1() (*backuprecoveryv1.BackupRecoveryManagementSreApiV1, error) {
+	session.configure("BackupRecovery", session.configureBackupRecovery)
 	return session.backupRecoveryManagerClient, session.backupRecoveryManagerClientErr
 }
 
 // IBM Cloud Secrets Manager V2 Basic API
-func (session clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+func (session *clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+	session.configure("SecretsManager", session.configureSecretsManager)
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.configure("SatelliteLink", session.configureSatelliteLink)
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.configure("Satellite", sess.configureSatellite)
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.configure("CisLogpushJobs", sess.configureCisLogpushJobs)
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1278,7 +1406,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.configure("CisMtls", sess.configureCisMtls)
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1286,7 +1415,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Bot Management
-func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+func (sess *clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+	sess.configure("CisBotManagement", sess.configureCisBotManagement)
 	if sess.cisBotManagementErr != nil {
 		return sess.cisBotManagementClient, sess.cisBotManagementErr
 	}
@@ -1294,7 +1424,8 @@ func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotMana
 }
 
 // CIS Bot Analytics
-func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+func (sess *clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+	sess.configure("CisBotAnalytics", sess.configureCisBotAnalytics)
 	if sess.cisBotAnalyticsErr != nil {
 		return sess.cisBotAnalyticsClient, sess.cisBotAnalyticsErr
 	}
@@ -1302,7 +1433,8 @@ func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyt
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.configure("CisWebhooks", sess.configureCisWebhooks)
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1310,7 +1442,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.configure("CisFilters", sess.configureCisFilters)
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1318,7 +1451,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.configure("CisFirewallRules", sess.configureCisFirewallRules)
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1326,55 +1460,66 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.configure("Atracker", session.configureAtracker)
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
 // Metrics Router API Version 3
-func (session clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+func (session *clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+	session.configure("MetricsRouter", session.configureMetricsRouter)
 	return session.metricsRouterClient, session.metricsRouterClientErr
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.configure("ESschemaRegistry", session.configureESschemaRegistry)
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
-func (session clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+func (session *clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+	session.configure("ESadminRest", session.configureESadminRest)
 	return session.esAdminRestClient, session.esAdminRestErr
 }
 
 // Security and Compliance center Admin API
-func (session clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+func (session *clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+	session.configure("SecurityAndComplianceCenter", session.configureSecurityAndComplianceCenter)
 	return session.securityAndComplianceCenterClient, session.securityAndComplianceCenterClientErr
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.configure("ContextBasedRestrictions", session.configureContextBasedRestrictions)
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.configure("CdToolchain", session.configureCdToolchain)
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.configure("CdTektonPipeline", session.configureCdTektonPipeline)
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
 // Code Engine
-func (session clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+func (session *clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+	session.configure("CodeEngine", session.configureCodeEngine)
 	return session.codeEngineClient, session.codeEngineClientErr
 }
 
 // Projects API Specification
-func (session clientSession) ProjectV1() (*project.ProjectV1, error) {
+func (session *clientSession) ProjectV1() (*project.ProjectV1, error) {
+	session.configure("Project", session.configureProject)
 	return session.projectClient, session.projectClientErr
 }
 
 // MQ SaaS
-func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+func (session *clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+	session.configure("Mqcloud", session.configureMqcloud)
 	if session.mqcloudClientErr != nil {
 		sessionMqcloudClient := session.mqcloudClient
 		sessionMqcloudClient.EnableRetries(0, 0)
@@ -1384,49 +1529,60 @@ func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
 }
 
 // sdsaas
-func (session clientSession) SdsaasV1() (*sdsaasv1.SdsaasV1, error) {
+func (session *clientSession) SdsaasV1() (*sdsaasv1.SdsaasV1, error) {
+	session.configure("Sdsaas", session.configureSdsaas)
 	return session.sdsaasClient, session.sdsaasClientErr
 }
 
 // VMware as a Service API
-func (session clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+func (session *clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+	session.configure("Vmware", session.configureVmware)
 	return session.vmwareClient, session.vmwareClientErr
 }
 
 // Cloud Logs
-func (session clientSession) LogsV0() (*logsv0.LogsV0, error) {
+func (session *clientSession) LogsV0() (*logsv0.LogsV0, error) {
+	session.configure("Logs", session.configureLogs)
 	return session.logsClient, session.logsClientErr
 }
 
 // IBM Cloud Logs Routing V1
-func (session clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+func (session *clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+	session.configure("IBMCloudLogsRouting", session.configureIBMCloudLogsRouting)
 	return session.ibmCloudLogsRoutingClient, session.ibmCloudLogsRoutingClientErr
 }
 
 // Logs Routing API V3
-func (session clientSession) LogsRouterV3() (*logsrouterv3.LogsRouterV3, error) {
+func (session *clientSession) LogsRouterV3() (*logsrouterv3.LogsRouterV3, error) {
+	session.configure("LogsRouter", session.configureLogsRouter)
 	return session.logsRouterClient, session.logsRouterClientErr
 }
 
 // GlobalCatalog Session
-func (sess clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+func (sess *clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+	sess.configure("GlobalCatalog", sess.configureGlobalCatalog)
 	return sess.globalCatalogClient, sess.globalCatalogClientErr
 }
 
 // Platform Notifications
-func (session clientSession) PlatformNotificationsV1() (*platformnotificationsv1.PlatformNotificationsV1, error) {
+func (session *clientSession) PlatformNotificationsV1() (*platformnotificationsv1.PlatformNotificationsV1, error) {
+	session.configure("PlatformNotifications", session.configurePlatformNotifications)
 	return session.platformNotificationsClient, session.platformNotificationsClientErr
 }
 
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
+	c.rateLimiter = sharedRateLimiter(c)
 	sess, fileMap, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
-		session: sess,
+	session := &clientSession{
+		session:    sess,
+		config:     c,
+		fileMap:    fileMap,
+		configured: map[string]*sync.Once{},
 	}
 
 	if sess.BluemixSession == nil {
@@ -1551,51 +1707,208 @@ func (c *Config) ClientSession() (interface{}, error) {
 		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
 	}
 
+	BluemixRegion = sess.BluemixSession.Config.Region
+
+	iamURL := EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, IAMURL)
+
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+		}
+	}
+	if fileMap != nil {
+		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+	}
+
+	var authenticator core.Authenticator
+
+	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
+		if c.IAMTrustedProfileID != "" {
+			authenticator, err = core.NewIamAssumeAuthenticatorBuilder().
+				SetApiKey(c.BluemixAPIKey).
+				SetIAMProfileID(c.IAMTrustedProfileID).
+				SetURL(EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL)).
+				SetClient(c.authHTTPClient()).
+				Build()
+			if err != nil {
+				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder. Error: %s", err)
+			}
+		} else {
+			authenticator, err = core.NewIamAssumeAuthenticatorBuilder().
+				SetApiKey(c.BluemixAPIKey).
+				SetIAMProfileName(c.IAMTrustedProfileName).
+				SetIAMAccountID(c.Account).
+				SetURL(EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL)).
+				SetClient(c.authHTTPClient()).
+				Build()
+			if err != nil {
+				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder with trusted profile name. Error: %s", err)
+			}
+
+		}
+	} else if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
+		if c.BluemixAPIKey != "" {
+			authenticator = &core.IamAuthenticator{
+				Client: c.authHTTPClient(),
+				ApiKey: c.BluemixAPIKey,
+				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			}
+		} else {
+			// Construct the IamAuthenticator with the IAM refresh token.
+			authenticator = &core.IamAuthenticator{
+				Client:       c.authHTTPClient(),
+				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
+				ClientId:     "bx",
+				ClientSecret: "bx",
+				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			}
+		}
+	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
+		}
+	} else {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
+		}
+	}
+
+	// CIS Service instances starts here.
+	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
+	if c.Visibility == "private" {
+		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
+		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+
+	}
+	if fileMap != nil {
+		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
+	}
+	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+
+	session.iamURL = iamURL
+	session.authenticator = authenticator
+	session.cisEndPoint = cisEndPoint
+
+	if os.Getenv("TF_LOG") != "" {
+		logDestination := log.Writer()
+		goLogger := log.New(logDestination, "", log.LstdFlags)
+		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+	}
+
+	// setting UserAgent for vpc-go-sdk common
+	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
+	return session, nil
+}
+
+// configureFunction builds the client returned by FunctionClient.
+func (session *clientSession) configureFunction() {
+	sess := session.session
+
 	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+}
 
-	BluemixRegion = sess.BluemixSession.Config.Region
+// configureAccountV1 builds the client returned by BluemixAcccountv1API.
+func (session *clientSession) configureAccountV1() {
+	sess := session.session
 
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 	}
 	session.bmxAccountv1ServiceAPI = accv1API
+}
+
+// configureAccountV2 builds the client returned by BluemixAcccountAPI.
+func (session *clientSession) configureAccountV2() {
+	sess := session.session
 
 	accAPI, err := accountv2.New(sess.BluemixSession)
 	if err != nil {
 		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 	}
 	session.bmxAccountServiceAPI = accAPI
+}
+
+// configureMccp builds the client returned by MccpAPI.
+func (session *clientSession) configureMccp() {
+	sess := session.session
 
 	cfAPI, err := mccpv2.New(sess.BluemixSession)
 	if err != nil {
 		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 	}
 	session.cfServiceAPI = cfAPI
+}
+
+// configureContainer builds the client returned by ContainerAPI.
+func (session *clientSession) configureContainer() {
+	sess := session.session
 
 	clusterAPI, err := containerv1.New(sess.BluemixSession)
 	if err != nil {
 		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
 	}
 	session.csServiceAPI = clusterAPI
+}
+
+// configureVpcContainer builds the client returned by VpcContainerAPI.
+func (session *clientSession) configureVpcContainer() {
+	sess := session.session
 
 	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
 	}
 	session.csv2ServiceAPI = v2clusterAPI
+}
+
+// configureHpcsEndpoint builds the client returned by HpcsEndpointAPI.
+func (session *clientSession) configureHpcsEndpoint() {
+	sess := session.session
 
 	hpcsAPI, err := hpcs.New(sess.BluemixSession)
 	if err != nil {
 		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
 	}
 	session.hpcsEndpointAPI = hpcsAPI
+}
+
+// configureKeyProtect builds the client returned by KeyProtectAPI.
+func (session *clientSession) configureKeyProtect() {
+	c := session.config
+	sess := session.session
+	fileMap := session.fileMap
 
 	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
 	}
 	var options kp.ClientConfig
@@ -1614,31 +1927,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
+	kpAPIclient, err := kp.New(options, c.httpTransport())
 	if err != nil {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
+}
 
-	iamURL := EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, IAMURL)
-
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
+// configureKeyManagement builds the client returned by KeyManagementAPI.
+func (session *clientSession) configureKeyManagement() {
+	c := session.config
+	sess := session.session
+	fileMap := session.fileMap
+	iamURL := session.iamURL
 
 	// KEY MANAGEMENT Service
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
 	}
 	var kmsOptions kp.ClientConfig
@@ -1659,67 +1967,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsAPIclient, err := kp.New(kmsOptions, c.httpTransport())
 	if err != nil {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
 	session.kmsAPI = kmsAPIclient
+}
 
-	var authenticator core.Authenticator
-
-	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
-		if c.IAMTrustedProfileID != "" {
-			authenticator, err = core.NewIamAssumeAuthenticatorBuilder().
-				SetApiKey(c.BluemixAPIKey).
-				SetIAMProfileID(c.IAMTrustedProfileID).
-				SetURL(EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL)).
-				Build()
-			if err != nil {
-				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder. Error: %s", err)
-			}
-		} else {
-			authenticator, err = core.NewIamAssumeAuthenticatorBuilder().
-				SetApiKey(c.BluemixAPIKey).
-				SetIAMProfileName(c.IAMTrustedProfileName).
-				SetIAMAccountID(c.Account).
-				SetURL(EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL)).
-				Build()
-			if err != nil {
-				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder with trusted profile name. Error: %s", err)
-			}
-
-		}
-	} else if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
-		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
-	}
+// configureBackupRecovery builds the client returned by BackupRecoveryV1, BackupRecoveryV1Connector and BackupRecoveryManagerV1.
+func (session *clientSession) configureBackupRecovery() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct the service options.
 	var backupRecoveryURL string = "https://default.backup-recovery.cloud.ibm.com/v2"
 	var backupRecoveryConnectorURL string
 	var backupRecoveryManagerURL string = "https://manager.backup-recovery.cloud.ibm.com/v2"
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		backupRecoveryURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_ENDPOINT", c.Region, backupRecoveryURL)
 		backupRecoveryConnectorURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT", c.Region, backupRecoveryConnectorURL)
 		backupRecoveryManagerURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT", c.Region, backupRecoveryConnectorURL)
@@ -1737,6 +2004,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.backupRecoveryClient != nil && session.backupRecoveryClient.Service != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.backupRecoveryClient.Service)
 		session.backupRecoveryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.backupRecoveryClient.SetDefaultHeaders(gohttp.Header{
@@ -1760,6 +2028,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.backupRecoveryConnectorClient != nil && session.backupRecoveryConnectorClient.Service != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.backupRecoveryConnectorClient.Service)
 		session.backupRecoveryConnectorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.backupRecoveryConnectorClient.SetDefaultHeaders(gohttp.Header{
@@ -1781,16 +2050,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.backupRecoveryManagerClient != nil && session.backupRecoveryManagerClient.Service != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.backupRecoveryManagerClient.Service)
 		session.backupRecoveryManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.backupRecoveryManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureProject builds the client returned by ProjectV1.
+func (session *clientSession) configureProject() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	projectEndpoint := project.DefaultServiceURL
 	// Construct an "options" struct for creating the service client.
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		projectEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PROJECT_API_ENDPOINT", c.Region, project.DefaultServiceURL)
 	}
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -1806,6 +2084,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.projectClient, err = project.NewProjectV1(projectClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.projectClient.Service)
 		session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.projectClient.SetDefaultHeaders(gohttp.Header{
@@ -1814,6 +2093,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
 	}
+}
+
+// configureLogs builds the client returned by LogsV0.
+func (session *clientSession) configureLogs() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an "options" struct for creating the service client.
 	logsEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.logs", c.Region), cloudEndpoint)
@@ -1821,7 +2108,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		logsEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.logs", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		logsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_API_ENDPOINT", c.Region, logsEndpoint)
 	}
 	logsClientOptions := &logsv0.LogsV0Options{
@@ -1833,6 +2120,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.logsClient, err = logsv0.NewLogsV0(logsClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.logsClient.Service)
 		session.logsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.logsClient.SetDefaultHeaders(gohttp.Header{
@@ -1841,14 +2129,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.logsClientErr = fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
 	}
+}
+
+// configureIBMCloudLogsRouting builds the client returned by IBMCloudLogsRoutingV0.
+func (session *clientSession) configureIBMCloudLogsRouting() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// LOGS ROUTER Version 0
 	var logsrouterClientURL string
 	var logsrouterURLErr error
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		logsrouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", c.Region, ibmcloudlogsroutingv0.DefaultServiceURL)
-	} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion("private." + c.Region)
 	} else {
 		logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion(c.Region)
@@ -1856,6 +2150,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if logsrouterURLErr != nil {
 		logsrouterClientURL = ibmcloudlogsroutingv0.DefaultServiceURL
 	}
+	if fileMap != nil {
+		logsrouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", c.Region, logsrouterClientURL)
+	}
 	ibmCloudLogsRoutingClientOptions := &ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0Options{
 		Authenticator: authenticator,
 		URL:           logsrouterClientURL,
@@ -1865,6 +2162,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.ibmCloudLogsRoutingClient, err = ibmcloudlogsroutingv0.NewIBMCloudLogsRoutingV0(ibmCloudLogsRoutingClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.ibmCloudLogsRoutingClient.Service)
 		session.ibmCloudLogsRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.ibmCloudLogsRoutingClient.SetDefaultHeaders(gohttp.Header{
@@ -1873,6 +2171,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.ibmCloudLogsRoutingClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Logs Routing service: %q", err)
 	}
+}
+
+// configureLogsRouter builds the client returned by LogsRouterV3.
+func (session *clientSession) configureLogsRouter() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// LOGS ROUTER V3
 	// Determine the correct region-based endpoint URL to use for the 'Logs Routing API Version 3' service.
@@ -1907,7 +2213,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 		// Code block 2 removed. Fall back to service's default endpoint URL.
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		logsRouterV3ClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT_V3", c.Region, logsRouterV3ClientURL)
 	}
 
@@ -1923,6 +2229,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.logsRouterClient, err = logsrouterv3.NewLogsRouterV3(logsRouterClientOptions)
 		if err == nil {
 			// Enable retries for API calls
+			c.setHTTPTransport(session.logsRouterClient.Service)
 			session.logsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 			// Add custom header for analytics
 			session.logsRouterClient.SetDefaultHeaders(gohttp.Header{
@@ -1932,6 +2239,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.logsRouterClientErr = fmt.Errorf("Error occurred while constructing 'Logs Routing API Version 3' service client: %q", err)
 		}
 	}
+}
+
+// configureUko builds the client returned by UkoV4.
+func (session *clientSession) configureUko() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an "options" struct for creating the service client.
 	ukoClientOptions := &ukov4.UkoV4Options{
@@ -1942,6 +2256,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.ukoClient.Service)
 		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.ukoClient.SetDefaultHeaders(gohttp.Header{
@@ -1950,13 +2265,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
 	}
+}
+
+// configureAppID builds the client returned by AppIDAPI.
+func (session *clientSession) configureAppID() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// APP ID Service
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
 	}
 	appIDClientOptions := &appid.AppIDManagementV4Options{
@@ -1968,12 +2290,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
+		c.setHTTPTransport(appIDClient.Service)
 		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.appidAPI = appIDClient
+}
+
+// configureContextBasedRestrictions builds the client returned by ContextBasedRestrictionsV1.
+func (session *clientSession) configureContextBasedRestrictions() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an "options" struct for creating Context Based Restrictions service client.
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
@@ -1984,7 +2315,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			cbrURL = ContructEndpoint("private.cbr", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
 	}
 	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
@@ -1996,6 +2327,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.contextBasedRestrictionsClient.Service)
 		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
@@ -2004,13 +2336,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
 	}
+}
+
+// configurePartnerCenterSell builds the client returned by PartnerCenterSellV1.
+func (session *clientSession) configurePartnerCenterSell() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// PARTNER CENTER SELL (product lifecycle) service
 	partnerCenterSellURL := "https://product-lifecycle.api.cloud.ibm.com/openapi/v1"
 	if c.Visibility == "private" {
 		session.partnerCenterSellClientErr = fmt.Errorf("partner center sell does not support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		partnerCenterSellURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT", c.Region, partnerCenterSellURL)
 	}
 	partnerCenterSellClientOptions := &partnercentersellv1.PartnerCenterSellV1Options{
@@ -2024,12 +2364,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.partnerCenterSellClient != nil && session.partnerCenterSellClient.Service != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.partnerCenterSellClient.Service)
 		session.partnerCenterSellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.partnerCenterSellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureUsageReports builds the client returned by UsageReportsV4.
+func (session *clientSession) configureUsageReports() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	//Usage Reports Service Client
 	usageReportsURL := usagereportsv4.DefaultServiceURL
@@ -2049,7 +2397,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			usageReportsURL = usagereportsv4.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
 	}
 	usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
@@ -2061,19 +2409,28 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
 	}
 	if usageReportsClient != nil && usageReportsClient.Service != nil {
+		c.setHTTPTransport(usageReportsClient.Service)
 		usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		usageReportsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.usageReportsClient = usageReportsClient
+}
+
+// configureCatalogManagement builds the client returned by CatalogManagementV1.
+func (session *clientSession) configureCatalogManagement() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// CATALOG MANAGEMENT Service
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
 	}
 	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
@@ -2087,12 +2444,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.catalogManagementClient.Service)
 		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureAtracker builds the client returned by AtrackerV2.
+func (session *clientSession) configureAtracker() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// ATRACKER Version 2
 	var atrackerClientV2URL string
@@ -2109,7 +2475,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if atrackerURLV2Err != nil {
 		atrackerClientV2URL = atrackerv2.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
 	}
 	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
@@ -2124,6 +2490,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.atrackerClientV2.Service)
 		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
@@ -2132,8 +2499,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
 	}
+}
+
+// configurePlatformNotifications builds the client returned by PlatformNotificationsV1.
+func (session *clientSession) configurePlatformNotifications() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	platformNotificationsUrl := platformnotificationsv1.DefaultServiceURL
+	if fileMap != nil {
+		platformNotificationsUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PLATFORM_NOTIFICATIONS_API_ENDPOINT", c.Region, platformNotificationsUrl)
+	}
 	// Construct an instance of the 'Platform Notifications' service.
 	if session.platformNotificationsClientErr == nil {
 		// Construct the service options.
@@ -2146,6 +2524,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.platformNotificationsClient, err = platformnotificationsv1.NewPlatformNotificationsV1(platformNotificationsClientOptions)
 		if err == nil {
 			// Enable retries for API calls
+			c.setHTTPTransport(session.platformNotificationsClient.Service)
 			session.platformNotificationsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 			// Add custom header for analytics
 			session.platformNotificationsClient.SetDefaultHeaders(gohttp.Header{
@@ -2155,6 +2534,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.platformNotificationsClientErr = fmt.Errorf("Error occurred while constructing 'Platform Notifications' service client: %q", err)
 		}
 	}
+}
+
+// configureMetricsRouter builds the client returned by MetricsRouterV3.
+func (session *clientSession) configureMetricsRouter() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an "options" struct for creating the service client for Metrics Router
 	var metricsRouterClientURL string
@@ -2171,7 +2558,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if metricsRouterURLV3Err != nil {
 		metricsRouterClientURL = metricsrouterv3.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		metricsRouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", c.Region, metricsRouterClientURL)
 	}
 	metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
@@ -2183,6 +2570,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.metricsRouterClient.Service)
 		session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
@@ -2191,6 +2579,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
 	}
+}
+
+// configureSecurityAndComplianceCenter builds the client returned by SecurityAndComplianceCenterV3.
+func (session *clientSession) configureSecurityAndComplianceCenter() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// SCC (Security and Compliance Center) Service
 	sccApiClientURL := scc.DefaultServiceURL
@@ -2198,6 +2594,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if regionURL, sccRegionErr := scc.GetServiceURLForRegion(c.Region); sccRegionErr == nil {
 		sccApiClientURL = regionURL
 	}
+	if fileMap != nil {
+		sccApiClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCC_API_ENDPOINT", c.Region, sccApiClientURL)
+	}
 	sccApiClientOptions := &scc.SecurityAndComplianceCenterApiV3Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_API_ENDPOINT"}, sccApiClientURL),
@@ -2207,6 +2606,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterV3(sccApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.securityAndComplianceCenterClient.Service)
 		session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
@@ -2215,6 +2615,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
 	}
+}
+
+// configureSchematics builds the client returned by SchematicsV1.
+func (session *clientSession) configureSchematics() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// SCHEMATICS Service
 	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
@@ -2222,7 +2629,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
 	}
 	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
@@ -2236,19 +2643,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
+		c.setHTTPTransport(schematicsClient.Service)
 		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.schematicsClient = schematicsClient
+}
+
+// configureVpc builds the client returned by VpcV1API and VpcV1BetaAPI.
+func (session *clientSession) configureVpc() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// VPC Service
 	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
 	}
 	vpcoptions := &vpc.VpcV1Options{
@@ -2260,6 +2675,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
+		c.setHTTPTransport(vpcclient.Service)
 		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2276,19 +2692,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
 	}
 	if vpcbetaclient != nil && vpcbetaclient.Service != nil {
+		c.setHTTPTransport(vpcbetaclient.Service)
 		vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		vpcbetaclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcBetaAPI = vpcbetaclient
+}
+
+// configurePushService builds the client returned by PushServiceV1.
+func (session *clientSession) configurePushService() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// PUSH NOTIFICATIONS Service
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
 	}
 	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
@@ -2301,12 +2725,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(pnclient.Service)
 		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.pushServiceClient = pnclient
+}
+
+// configureEventNotifications builds the client returned by EventNotificationsApiV1.
+func (session *clientSession) configureEventNotifications() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// event notifications
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
@@ -2315,7 +2748,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		enurl = fmt.Sprintf("https://private.%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 	}
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
 	}
 	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
@@ -2330,18 +2763,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.eventNotificationsApiClient.Service)
 		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureAppConfiguration builds the client returned by AppConfigurationV1.
+func (session *clientSession) configureAppConfiguration() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// APP CONFIGURATION Service
 	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
 	}
 	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
@@ -2352,11 +2793,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(appConfigClient.Service)
 		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
 	}
+}
+
+// configureContainerRegistry builds the client returned by ContainerRegistryV1.
+func (session *clientSession) configureContainerRegistry() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
 
 	// CONTAINER REGISTRY Service
 	// Construct an "options" struct for creating the service client.
@@ -2368,7 +2818,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		containerRegistryClientURL = strings.Replace(containerRegistryClientURL, "https://", "https://private.", 1)
 
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
 	}
 	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
@@ -2383,16 +2833,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.containerRegistryClient.Service)
 		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCosConfig builds the client returned by CosConfigV1API.
+func (session *clientSession) configureCosConfig() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// OBJECT STORAGE Service
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
 	}
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
@@ -2403,19 +2861,41 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
 	}
+	if cosconfigclient != nil && cosconfigclient.Service != nil {
+		c.setHTTPTransport(cosconfigclient.Service)
+		cosconfigclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+	}
 	session.cosConfigAPI = cosconfigclient
+}
+
+// configureGlobalSearch builds the client returned by GlobalSearchAPI.
+func (session *clientSession) configureGlobalSearch() {
+	sess := session.session
 
 	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
 	if err != nil {
 		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	session.globalSearchServiceAPI = globalSearchAPI
+}
+
+// configureGlobalTagging builds the client returned by GlobalTaggingAPI.
+func (session *clientSession) configureGlobalTagging() {
+	sess := session.session
+
 	// Global Tagging Bluemix-go
 	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
 	if err != nil {
 		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
 	}
 	session.globalTaggingServiceAPI = globalTaggingAPI
+}
+
+// configureGlobalTaggingV1 builds the client returned by GlobalTaggingAPIv1.
+func (session *clientSession) configureGlobalTaggingV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// GLOBAL TAGGING Service
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
@@ -2428,7 +2908,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
 	}
 	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
@@ -2441,11 +2921,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
+		c.setHTTPTransport(session.globalTaggingServiceAPIV1.Service)
 		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureGlobalSearchV2 builds the client returned by GlobalSearchAPIV2.
+func (session *clientSession) configureGlobalSearchV2() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+
 	// GLOBAL TAGGING Service
 	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2457,7 +2946,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalSearchEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s", globalSearchRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
 	}
 	globalSearchV2Options := &searchv2.GlobalSearchV2Options{
@@ -2470,17 +2959,31 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
 		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
+		c.setHTTPTransport(session.globalSearchServiceAPIV2.Service)
 		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureICD builds the client returned by ICDAPI.
+func (session *clientSession) configureICD() {
+	sess := session.session
 
 	icdAPI, err := icdv4.New(sess.BluemixSession)
 	if err != nil {
 		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
 	}
 	session.icdServiceAPI = icdAPI
+}
+
+// configureCloudDatabases builds the client returned by CloudDatabasesV5.
+func (session *clientSession) configureCloudDatabases() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	var cloudDatabasesEndpoint string
 
@@ -2489,6 +2992,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.databases.cloud.ibm.com/v5/ibm", c.Region)
 	}
+	if fileMap != nil {
+		cloudDatabasesEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DATABASES_API_ENDPOINT", c.Region, cloudDatabasesEndpoint)
+	}
 
 	// Construct an "options" struct for creating the service client.
 	cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
@@ -2500,6 +3006,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.cloudDatabasesClient.Service)
 		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
@@ -2508,45 +3015,86 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
 	}
+}
+
+// configureResourceCatalog builds the client returned by ResourceCatalogAPI.
+func (session *clientSession) configureResourceCatalog() {
+	sess := session.session
 
 	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
 	}
 	session.resourceCatalogServiceAPI = resourceCatalogAPI
+}
+
+// configureResourceManagementV2 builds the client returned by ResourceManagementAPIv2.
+func (session *clientSession) configureResourceManagementV2() {
+	sess := session.session
 
 	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
 	}
 	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+}
+
+// configureBluemixResourceController builds the client returned by ResourceControllerAPI.
+func (session *clientSession) configureBluemixResourceController() {
+	sess := session.session
 
 	resourceControllerAPI, err := controller.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	session.resourceControllerServiceAPI = resourceControllerAPI
+}
+
+// configureBluemixResourceControllerV2 builds the client returned by ResourceControllerAPIV2.
+func (session *clientSession) configureBluemixResourceControllerV2() {
+	sess := session.session
 
 	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
 	}
 	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+}
+
+// configureUserManagement builds the client returned by UserManagementAPI.
+func (session *clientSession) configureUserManagement() {
+	sess := session.session
 
 	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
 	}
 	session.userManagementAPI = userManagementAPI
+}
+
+// configureFunctionIAMNamespace builds the client returned by FunctionIAMNamespaceAPI.
+func (session *clientSession) configureFunctionIAMNamespace() {
+	sess := session.session
 
 	namespaceFunction, err := functions.New(sess.BluemixSession)
 	if err != nil {
 		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
 	}
 	session.functionIAMNamespaceAPI = namespaceFunction
+}
+
+// configureIBMPI builds the client returned by IBMPISession.
+func (session *clientSession) configureIBMPI() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
 
 	// POWER SYSTEMS Service
 	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
+	if fileMap != nil {
+		piURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PI_API_ENDPOINT", c.Region, piURL)
+	}
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
 		Authenticator: authenticator,
 		Debug:         os.Getenv("TF_LOG") != "",
@@ -2559,14 +3107,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
 	}
+	if ibmpisession != nil && (c.HTTPTransport != nil || c.rateLimiter != nil) {
+		if runtime, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+			runtime.Transport = c.retryingHTTPTransport()
+		}
+	}
 	session.ibmpiSession = ibmpisession
+}
+
+// configurePrivateDNS builds the client returned by PrivateDNSClientSession.
+func (session *clientSession) configurePrivateDNS() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// PRIVATE DNS Service
 	pdnsURL := dns.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
 	}
 	dnsOptions := &dns.DnsSvcsV1Options{
@@ -2578,11 +3138,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
+		c.setHTTPTransport(session.pDNSClient.Service)
 		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureDirectlink builds the client returned by DirectlinkV1API.
+func (session *clientSession) configureDirectlink() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// DIRECT LINK Service
 	ver := time.Now().Format("2006-01-02")
@@ -2590,7 +3158,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
 	}
 	directlinkOptions := &dl.DirectLinkV1Options{
@@ -2603,18 +3171,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
+		c.setHTTPTransport(session.directlinkAPI.Service)
 		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureDirectlinkProvider builds the client returned by DirectlinkProviderV2API.
+func (session *clientSession) configureDirectlinkProvider() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	ver := time.Now().Format("2006-01-02")
 
 	// DIRECT LINK PROVIDER Service
 	dlproviderURL := dlProviderV2.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
 	}
 	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
@@ -2627,18 +3204,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
+		c.setHTTPTransport(session.dlProviderAPI.Service)
 		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureTransitGateway builds the client returned by TransitGatewayV1API.
+func (session *clientSession) configureTransitGateway() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// TRANSIT GATEWAY Service
 	tgURL := tg.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
 	}
 	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
@@ -2651,18 +3236,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
+		c.setHTTPTransport(session.transitgatewayAPI.Service)
 		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
 	}
+}
+
+// configureConfigurationAggregator builds the client returned by ConfigurationAggregatorV1.
+func (session *clientSession) configureConfigurationAggregator() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an instance of the 'Configuration Aggregator' service.
 	configBaseURL := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		configBaseURL = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		configBaseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, configBaseURL)
 	}
 	configurationAggregatorClientOptions := &configurationaggregatorv1.ConfigurationAggregatorV1Options{
@@ -2674,6 +3268,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.configurationAggregatorClient, err = configurationaggregatorv1.NewConfigurationAggregatorV1(configurationAggregatorClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.configurationAggregatorClient.Service)
 		session.configurationAggregatorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.configurationAggregatorClient.SetDefaultHeaders(gohttp.Header{
@@ -2682,11 +3277,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.configurationAggregatorClientErr = fmt.Errorf("Error occurred while constructing 'Configuration Aggregator' service client: %q", err)
 	}
+}
+
+// configureDb2saas builds the client returned by Db2saasV1.
+func (session *clientSession) configureDb2saas() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an instance of the 'IBM Db2 SaaS on Cloud REST API' service.
 	if session.db2saasClientErr == nil {
 		// Construct the service options.
 		defaultServiceEndpoint := "https://us-south.db2.saas.ibm.com/dbapi/v4"
+		if fileMap != nil {
+			defaultServiceEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DB2_API_ENDPOINT", c.Region, defaultServiceEndpoint)
+		}
 		db2saasClientOptions := &db2saasv1.Db2saasV1Options{
 			URL:           EnvFallBack([]string{"IBMCLOUD_DB2_API_ENDPOINT"}, defaultServiceEndpoint),
 			Authenticator: authenticator,
@@ -2696,6 +3302,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.db2saasClient, err = db2saasv1.NewDb2saasV1(db2saasClientOptions)
 		if err == nil {
 			// Enable retries for API calls
+			c.setHTTPTransport(session.db2saasClient.Service)
 			session.db2saasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 			// Add custom header for analytics
 			session.db2saasClient.SetDefaultHeaders(gohttp.Header{
@@ -2705,41 +3312,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.db2saasClientErr = fmt.Errorf("Error occurred while constructing 'IBM Db2 SaaS on Cloud REST API' service client: %q", err)
 		}
 	}
+}
 
-	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+// configureCisZones builds the client returned by CisZonesV1ClientSession.
+func (session *clientSession) configureCisZones() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Zones service
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
@@ -2754,11 +3333,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
+		c.setHTTPTransport(session.cisZonesV1Client.Service)
 		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisDNSRecords builds the client returned by CisDNSRecordClientSession.
+func (session *clientSession) configureCisDNSRecords() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS DNS Record service
 	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
@@ -2772,11 +3359,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
+		c.setHTTPTransport(session.cisDNSRecordsClient.Service)
 		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisDNSRecordBulk builds the client returned by CisDNSRecordBulkClientSession.
+func (session *clientSession) configureCisDNSRecordBulk() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS DNS Record bulk service
 	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
@@ -2792,11 +3387,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
+		c.setHTTPTransport(session.cisDNSRecordBulkClient.Service)
 		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisGLBPool builds the client returned by CisGLBPoolClientSession.
+func (session *clientSession) configureCisGLBPool() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Global load balancer pool
 	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
@@ -2810,11 +3413,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
+		c.setHTTPTransport(session.cisGLBPoolClient.Service)
 		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisGLB builds the client returned by CisGLBClientSession.
+func (session *clientSession) configureCisGLB() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Global load balancer
 	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
@@ -2829,11 +3440,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
+		c.setHTTPTransport(session.cisGLBClient.Service)
 		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisGLBHealthCheck builds the client returned by CisGLBHealthCheckClientSession.
+func (session *clientSession) configureCisGLBHealthCheck() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Global load balancer health check/monitor
 	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
@@ -2847,11 +3466,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
+		c.setHTTPTransport(session.cisGLBHealthCheckClient.Service)
 		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisIP builds the client returned by CisIPClientSession.
+func (session *clientSession) configureCisIP() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS IP
 	cisIPOpt := &cisipv1.CisIpApiV1Options{
@@ -2864,11 +3491,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
+		c.setHTTPTransport(session.cisIPClient.Service)
 		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisRL builds the client returned by CisRLClientSession.
+func (session *clientSession) configureCisRL() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Zone Rate Limit
 	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
@@ -2884,11 +3519,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
+		c.setHTTPTransport(session.cisRLClient.Service)
 		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisAlerts builds the client returned by CisAlertsSession.
+func (session *clientSession) configureCisAlerts() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
+
 	// IBM Network CIS Alerts
 	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
 		URL:           cisEndPoint,
@@ -2901,11 +3545,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisAlertsErr)
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
+		c.setHTTPTransport(session.cisAlertsClient.Service)
 		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisRulesets builds the client returned by CisRulesetsSession.
+func (session *clientSession) configureCisRulesets() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Rulesets
 	cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
@@ -2920,11 +3572,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRulesetsErr)
 	}
 	if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
+		c.setHTTPTransport(session.cisRulesetsClient.Service)
 		session.cisRulesetsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisPageRule builds the client returned by CisPageRuleClientSession.
+func (session *clientSession) configureCisPageRule() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Page Rules
 	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
@@ -2940,11 +3600,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
+		c.setHTTPTransport(session.cisPageRuleClient.Service)
 		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisEdgeFunction builds the client returned by CisEdgeFunctionClientSession.
+func (session *clientSession) configureCisEdgeFunction() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Edge Function
 	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
@@ -2959,11 +3627,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
+		c.setHTTPTransport(session.cisEdgeFunctionClient.Service)
 		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisSSL builds the client returned by CisSSLClientSession.
+func (session *clientSession) configureCisSSL() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS SSL certificate
 	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
@@ -2979,11 +3655,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
+		c.setHTTPTransport(session.cisSSLClient.Service)
 		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisWAFPackage builds the client returned by CisWAFPackageClientSession.
+func (session *clientSession) configureCisWAFPackage() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS WAF Package
 	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
@@ -2998,11 +3682,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
+		c.setHTTPTransport(session.cisWAFPackageClient.Service)
 		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisDomainSettings builds the client returned by CisDomainSettingsClientSession.
+func (session *clientSession) configureCisDomainSettings() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Domain settings
 	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
@@ -3017,11 +3709,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
+		c.setHTTPTransport(session.cisDomainSettingsClient.Service)
 		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisRouting builds the client returned by CisRoutingClientSession.
+func (session *clientSession) configureCisRouting() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Routing
 	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
@@ -3036,11 +3736,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
+		c.setHTTPTransport(session.cisRoutingClient.Service)
 		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisWAFGroup builds the client returned by CisWAFGroupClientSession.
+func (session *clientSession) configureCisWAFGroup() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS WAF Group
 	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
@@ -3055,11 +3763,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
+		c.setHTTPTransport(session.cisWAFGroupClient.Service)
 		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisCache builds the client returned by CisCacheClientSession.
+func (session *clientSession) configureCisCache() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Cache service
 	cisCacheOpt := &ciscachev1.CachingApiV1Options{
@@ -3074,11 +3790,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
+		c.setHTTPTransport(session.cisCacheClient.Service)
 		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisCustomPage builds the client returned by CisCustomPageClientSession.
+func (session *clientSession) configureCisCustomPage() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Custom pages service
 	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
@@ -3094,11 +3818,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
+		c.setHTTPTransport(session.cisCustomPageClient.Service)
 		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisAccessRule builds the client returned by CisAccessRuleClientSession.
+func (session *clientSession) configureCisAccessRule() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Firewall Access rule
 	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
@@ -3113,11 +3845,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
+		c.setHTTPTransport(session.cisAccessRuleClient.Service)
 		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisUARule builds the client returned by CisUARuleClientSession.
+func (session *clientSession) configureCisUARule() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Firewall User Agent Blocking rule
 	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
@@ -3132,11 +3872,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
+		c.setHTTPTransport(session.cisUARuleClient.Service)
 		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisLockdown builds the client returned by CisLockdownClientSession.
+func (session *clientSession) configureCisLockdown() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Firewall Lockdown rule
 	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
@@ -3151,11 +3899,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
+		c.setHTTPTransport(session.cisLockdownClient.Service)
 		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisRangeApp builds the client returned by CisRangeAppClientSession.
+func (session *clientSession) configureCisRangeApp() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Range Application rule
 	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
@@ -3170,11 +3926,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
+		c.setHTTPTransport(session.cisRangeAppClient.Service)
 		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisWAFRule builds the client returned by CisWAFRuleClientSession.
+func (session *clientSession) configureCisWAFRule() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS WAF Rule Service
 	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
@@ -3190,11 +3954,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
+		c.setHTTPTransport(session.cisWAFRuleClient.Service)
 		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisLogpushJobs builds the client returned by CisLogpushJobsSession.
+func (session *clientSession) configureCisLogpushJobs() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS LogpushJobs
 	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
@@ -3210,11 +3982,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisLogpushJobsErr)
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
+		c.setHTTPTransport(session.cisLogpushJobsClient.Service)
 		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisMtls builds the client returned by CisMtlsSession.
+func (session *clientSession) configureCisMtls() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM MTLS Session
 	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
@@ -3228,11 +4008,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisMtlsErr)
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
+		c.setHTTPTransport(session.cisMtlsClient.Service)
 		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisBotManagement builds the client returned by CisBotManagementSession.
+func (session *clientSession) configureCisBotManagement() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Bot Management
 	cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
@@ -3247,11 +4035,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisBotManagementErr)
 	}
 	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
+		c.setHTTPTransport(session.cisBotManagementClient.Service)
 		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisBotAnalytics builds the client returned by CisBotAnalyticsSession.
+func (session *clientSession) configureCisBotAnalytics() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Bot Analytics
 	cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
@@ -3266,11 +4062,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisBotAnalyticsErr)
 	}
 	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
+		c.setHTTPTransport(session.cisBotAnalyticsClient.Service)
 		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisWebhooks builds the client returned by CisWebhookSession.
+func (session *clientSession) configureCisWebhooks() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Webhooks
 	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
@@ -3284,11 +4088,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWebhooksErr)
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
+		c.setHTTPTransport(session.cisWebhooksClient.Service)
 		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisFilters builds the client returned by CisFiltersSession.
+func (session *clientSession) configureCisFilters() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
+
 	// IBM Network CIS Filters
 	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
 		URL:           cisEndPoint,
@@ -3300,11 +4113,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
+		c.setHTTPTransport(session.cisFiltersClient.Service)
 		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisFirewallRules builds the client returned by CisFirewallRulesSession.
+func (session *clientSession) configureCisFirewallRules() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Firewall rules
 	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
@@ -3317,11 +4138,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
+		c.setHTTPTransport(session.cisFirewallRulesClient.Service)
 		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisOriginAuth builds the client returned by CisOrigAuthSession.
+func (session *clientSession) configureCisOriginAuth() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Authenticated Origin Pull
 	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
@@ -3338,11 +4167,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisOriginAuthPullErr)
 	}
 	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
+		c.setHTTPTransport(session.cisOriginAuthClient.Service)
 		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisLists builds the client returned by CisListsSession.
+func (session *clientSession) configureCisLists() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 
 	// IBM Network CIS Lists
 	cisListsOpt := &cislistsapiv1.ListsApiV1Options{
@@ -3359,25 +4196,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisListsErr)
 	}
 	if session.cisListsClient != nil && session.cisListsClient.Service != nil {
+		c.setHTTPTransport(session.cisListsClient.Service)
 		session.cisListsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisListsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureAccountManagement builds the client returned by AccountManagementV4.
+func (session *clientSession) configureAccountManagement() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// IAM IDENTITY Service
-	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
-	iamIdenityURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamIdenityURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
-	}
 	// ACCOUNT MANAGEMENT Service
 	accountManagementURL := accountmanagementv4.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -3385,6 +4217,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 			accountManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
 		}
 	}
+	if fileMap != nil {
+		accountManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", c.Region, accountManagementURL)
+	}
 	accountManagementOptions := &accountmanagementv4.AccountManagementV4Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT"}, accountManagementURL),
@@ -3394,12 +4229,34 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.accountManagementErr = fmt.Errorf("[ERROR] Error occurred while configuring Account Management service: %q", err)
 	}
 	if accountManagementClient != nil && accountManagementClient.Service != nil {
+		c.setHTTPTransport(accountManagementClient.Service)
 		accountManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		accountManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.accountManagementAPI = accountManagementClient
+}
+
+// configureIAMIdentity builds the client returned by IAMIdentityV1API.
+func (session *clientSession) configureIAMIdentity() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+
+	// IAM IDENTITY Service
+	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
+	iamIdenityURL := iamidentity.DefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamIdenityURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
+		}
+	}
+	if fileMap != nil {
+		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
+	}
 
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
 		Authenticator: authenticator,
@@ -3410,12 +4267,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
+		c.setHTTPTransport(iamIdentityClient.Service)
 		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamIdentityAPI = iamIdentityClient
+}
+
+// configureIAMPolicyManagement builds the client returned by IAMPolicyManagementV1API.
+func (session *clientSession) configureIAMPolicyManagement() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// IAM POLICY MANAGEMENT Service
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
@@ -3426,7 +4291,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
 	}
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
@@ -3438,12 +4303,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
+		c.setHTTPTransport(iamPolicyManagementClient.Service)
 		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
+}
+
+// configureIAMAccessGroups builds the client returned by IAMAccessGroupsV2.
+func (session *clientSession) configureIAMAccessGroups() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// IAM ACCESS GROUP
 	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
@@ -3454,7 +4327,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
 	}
 	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
@@ -3466,12 +4339,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
+		c.setHTTPTransport(iamAccessGroupsClient.Service)
 		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamAccessGroupsAPI = iamAccessGroupsClient
+}
+
+// configureResourceManager builds the client returned by ResourceManagerV2API.
+func (session *clientSession) configureResourceManager() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// RESOURCE MANAGEMENT Service
 	rmURL := resourcemanager.DefaultServiceURL
@@ -3490,7 +4371,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rmURL = resourcemanager.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
 	}
 	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
@@ -3502,16 +4383,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
+		c.setHTTPTransport(resourceManagerClient.Service)
 		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceManagerAPI = resourceManagerClient
+}
+
+// configureIBMCloudShell builds the client returned by IBMCloudShellV1.
+func (session *clientSession) configureIBMCloudShell() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// CLOUD SHELL Service
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
 	}
 	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
@@ -3523,11 +4413,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
+		c.setHTTPTransport(session.ibmCloudShellClient.Service)
 		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureEnterpriseManagement builds the client returned by EnterpriseManagementV1.
+func (session *clientSession) configureEnterpriseManagement() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// ENTERPRISE Service
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
@@ -3547,7 +4445,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
 	}
 	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
@@ -3559,12 +4457,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
+		c.setHTTPTransport(enterpriseManagementClient.Service)
 		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
+}
+
+// configureResourceController builds the client returned by ResourceControllerV2API.
+func (session *clientSession) configureResourceController() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// RESOURCE CONTROLLER Service
 	rcURL := resourcecontroller.DefaultServiceURL
@@ -3583,7 +4489,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rcURL = resourcecontroller.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
 	}
 	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
@@ -3595,12 +4501,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
+		c.setHTTPTransport(resourceControllerClient.Service)
 		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceControllerAPI = resourceControllerClient
+}
+
+// configureDrAutomationService builds the client returned by DrAutomationServiceV1.
+func (session *clientSession) configureDrAutomationService() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an instance of the 'DrAutomation Service' service.
 	if session.drAutomationServiceClientErr == nil {
@@ -3613,6 +4527,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.drAutomationServiceClient, err = drautomationservicev1.NewDrAutomationServiceV1(drAutomationServiceClientOptions)
 		if err == nil {
 			// Enable retries for API calls
+			c.setHTTPTransport(session.drAutomationServiceClient.Service)
 			session.drAutomationServiceClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 			// Add custom header for analytics
 			session.drAutomationServiceClient.SetDefaultHeaders(gohttp.Header{
@@ -3622,6 +4537,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.drAutomationServiceClientErr = fmt.Errorf("error occurred while constructing 'DrAutomation Service' service client: %q", err)
 		}
 	}
+}
+
+// configurePowerhaAutomationService builds the client returned by PowerhaAutomationServiceV1.
+func (session *clientSession) configurePowerhaAutomationService() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an instance of the 'PowerhaAutomation Service' service.
 	if session.powerhaAutomationServiceClientErr == nil {
@@ -3634,6 +4556,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.powerhaAutomationServiceClient, err = powerhaautomationservicev1.NewPowerhaAutomationServiceV1(powerhaAutomationServiceClientOptions)
 		if err == nil {
 			// Enable retries for API calls
+			c.setHTTPTransport(session.powerhaAutomationServiceClient.Service)
 			session.powerhaAutomationServiceClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 			// Add custom header for analytics
 			session.powerhaAutomationServiceClient.SetDefaultHeaders(gohttp.Header{
@@ -3643,6 +4566,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.powerhaAutomationServiceClientErr = fmt.Errorf("Error occurred while constructing 'PowerhaAutomation Service' service client: %q", err)
 		}
 	}
+}
+
+// configureSecretsManager builds the client returned by SecretsManagerV2.
+func (session *clientSession) configureSecretsManager() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
 	// SECRETS MANAGER Service V2
 	// Construct an "options" struct for creating the service client.
@@ -3662,6 +4592,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.secretsManagerClient.Service)
 		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
@@ -3670,13 +4601,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
 	}
+}
+
+// configureSatellite builds the client returned by SatelliteClientSession.
+func (session *clientSession) configureSatellite() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// SATELLITE Service
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
 	}
 	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
@@ -3690,11 +4629,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
+		c.setHTTPTransport(session.satelliteClient.Service)
 		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureSatelliteLink builds the client returned by SatellitLinkClientSession.
+func (session *clientSession) configureSatelliteLink() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// SATELLITE LINK Service
 	// Construct an "options" struct for creating the service client.
@@ -3702,7 +4650,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
 	}
 	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
@@ -3715,12 +4663,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.satelliteLinkClient.Service)
 		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureESschemaRegistry builds the client returned by ESschemaRegistrySession.
+func (session *clientSession) configureESschemaRegistry() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
 	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
 		Authenticator: authenticator,
@@ -3730,11 +4686,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
+		c.setHTTPTransport(session.esSchemaRegistryClient.Service)
 		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureESadminRest builds the client returned by ESadminRestSession.
+func (session *clientSession) configureESadminRest() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
 	esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
 		Authenticator: authenticator,
@@ -3744,17 +4708,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.esAdminRestErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams admin rest: %q", err)
 	}
 	if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
+		c.setHTTPTransport(session.esAdminRestClient.Service)
 		session.esAdminRestClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCdToolchain builds the client returned by CdToolchainV2.
+func (session *clientSession) configureCdToolchain() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an "options" struct for creating the service client.
 	var cdToolchainClientURL string
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
-	} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
 		if err != nil && c.Visibility == "public-and-private" {
 			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
@@ -3762,7 +4733,10 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
 	}
-	if err != nil {
+	if fileMap != nil {
+		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
+	}
+	if err != nil && cdToolchainClientURL == "" {
 		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 	}
 	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
@@ -3774,6 +4748,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.cdToolchainClient.Service)
 		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
@@ -3782,12 +4757,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 	}
+}
+
+// configureCdTektonPipeline builds the client returned by CdTektonPipelineV2.
+func (session *clientSession) configureCdTektonPipeline() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an "options" struct for creating the tekton pipeline service client.
 	var cdTektonPipelineClientURL string
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
-	} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
 		if err != nil && c.Visibility == "public-and-private" {
 			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
@@ -3798,6 +4779,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
 	}
+	if fileMap != nil {
+		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
+	}
 	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
@@ -3806,6 +4790,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.cdTektonPipelineClient.Service)
 		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
@@ -3814,13 +4799,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
 	}
+}
+
+// configureMqcloud builds the client returned by MqcloudV1.
+func (session *clientSession) configureMqcloud() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// MQaaS Service Configuration
 	mqCloudURL := ContructEndpoint(fmt.Sprintf("api.%s.mq2", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		mqCloudURL = ContructEndpoint(fmt.Sprintf("api.private.%s.mq2", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		mqCloudURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", c.Region, mqCloudURL)
 	}
 
@@ -3833,6 +4826,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.mqcloudClient, err = mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.mqcloudClient.Service)
 		session.mqcloudClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
@@ -3841,12 +4835,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.mqcloudClientErr = fmt.Errorf("Error occurred while constructing 'MQ SaaS' service client: %q", err)
 	}
+}
+
+// configureVmware builds the client returned by VmwareV1.
+func (session *clientSession) configureVmware() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// VMware Cloud Foundation as a Service
 	// Construct an instance of the 'VMware Cloud Foundation as a Service API' service.
 	if session.vmwareClientErr == nil {
 		// Construct the service options.
 		vmwareURL := ContructEndpoint(fmt.Sprintf("api.%s.vmware", c.Region), cloudEndpoint+"/v1")
+		if fileMap != nil {
+			vmwareURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_VMWARE_URL", c.Region, vmwareURL)
+		}
 		vmwareClientOptions := &vmwarev1.VmwareV1Options{
 			Authenticator: authenticator,
 			URL:           EnvFallBack([]string{"IBMCLOUD_VMWARE_URL"}, vmwareURL),
@@ -3856,6 +4861,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vmwareClient, err = vmwarev1.NewVmwareV1(vmwareClientOptions)
 		if err == nil {
 			// Enable retries for API calls
+			c.setHTTPTransport(session.vmwareClient.Service)
 			session.vmwareClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 			// Add custom header for analytics
 			session.vmwareClient.SetDefaultHeaders(gohttp.Header{
@@ -3865,13 +4871,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.vmwareClientErr = fmt.Errorf("Error occurred while constructing 'VMware Cloud Foundation as a Service API' service client: %q", err)
 		}
 	}
+}
+
+// configureCodeEngine builds the client returned by CodeEngineV2.
+func (session *clientSession) configureCodeEngine() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct the service options.
 	codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		codeEngineEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.codeengine", c.Region), cloudEndpoint+"/v2")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		codeEngineEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", c.Region, codeEngineEndpoint)
 	}
 	codeEngineClientOptions := &codeengine.CodeEngineV2Options{
@@ -3884,6 +4898,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.codeEngineClient.Service)
 		session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
@@ -3892,6 +4907,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
 	}
+}
+
+// configureSdsaas builds the client returned by SdsaasV1.
+func (session *clientSession) configureSdsaas() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an instance of the 'sdsaas' service.
 	if session.sdsaasClientErr == nil {
@@ -3904,6 +4926,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.sdsaasClient, err = sdsaasv1.NewSdsaasV1(sdsaasClientOptions)
 		if err == nil {
 			// Enable retries for API calls
+			c.setHTTPTransport(session.sdsaasClient.Service)
 			session.sdsaasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 			// Add custom header for analytics
 			session.sdsaasClient.SetDefaultHeaders(gohttp.Header{
@@ -3913,6 +4936,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.sdsaasClientErr = fmt.Errorf("Error occurred while constructing 'sdsaas' service client: %q", err)
 		}
 	}
+}
+
+// configureGlobalCatalog builds the client returned by GlobalCatalogV1API.
+func (session *clientSession) configureGlobalCatalog() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
 	// CATALOG MANAGEMENT Service
 	globalcatalogURL := globalcatalogv1.DefaultServiceURL
@@ -3923,7 +4953,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			globalcatalogURL = ContructEndpoint("private.us-south.globalcatalog", fmt.Sprintf("%s", cloudEndpoint))
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		globalcatalogURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", c.Region, globalcatalogURL)
 	}
 	gurl := EnvFallBack([]string{"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT"}, globalcatalogURL)
@@ -3949,22 +4979,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.globalCatalogClient != nil && session.globalCatalogClient.Service != nil {
 		// Enable retries for API calls
+		c.setHTTPTransport(session.globalCatalogClient.Service)
 		session.globalCatalogClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
 		session.globalCatalogClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-
-	if os.Getenv("TF_LOG") != "" {
-		logDestination := log.Writer()
-		goLogger := log.New(logDestination, "", log.LstdFlags)
-		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
-	}
-
-	// setting UserAgent for vpc-go-sdk common
-	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
-	return session, nil
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -4003,19 +5024,11 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 	var err error
 	var fileMap map[string]interface{}
 	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
-		}
-		defer jsonFile.Close()
-		bytes, err := ioutil.ReadAll(jsonFile)
+		fileMap, err = LoadEndpointsFile(f)
 		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
-		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
+			return nil, nil, err
 		}
+		log.Printf("[INFO] Using endpoints from Endpoints File %s", f)
 	}
 	iamURL := EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, IAMURL)
 
@@ -4026,7 +5039,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
+	if fileMap != nil {
 		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
 	}
 	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
@@ -4036,6 +5049,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 				SetApiKey(c.BluemixAPIKey).
 				SetIAMProfileID(c.IAMTrustedProfileID).
 				SetURL(iamURL).
+				SetClient(c.authHTTPClient()).
 				Build()
 			if err != nil {
 				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder. Error: %s", err)
@@ -4047,6 +5061,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 				SetIAMProfileName(c.IAMTrustedProfileName).
 				SetIAMAccountID(c.Account).
 				SetURL(iamURL).
+				SetClient(c.authHTTPClient()).
 				Build()
 			if err != nil {
 				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder with trusted profile name. Error: %s", err)
@@ -4056,6 +5071,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 		if c.BluemixAPIKey != "" {
 			log.Println("Configuring Session with API Key")
 			authenticator = &core.IamAuthenticator{
+				Client:       c.authHTTPClient(),
 				ApiKey:       c.BluemixAPIKey,
 				URL:          iamURL,
 				ClientId:     "bx",
@@ -4065,6 +5081,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 			// Construct the IamAuthenticator with the IAM refresh token.
 			log.Println("Configuring Session with refresh token")
 			authenticator = &core.IamAuthenticator{
+				Client:       c.authHTTPClient(),
 				RefreshToken: c.IAMRefreshToken,
 				ClientId:     "bx",
 				ClientSecret: "bx",
@@ -4101,6 +5118,12 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 		UserAgent:           fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		Authenticator:       authenticator,
 	}
+	if c.HTTPTransport != nil || c.rateLimiter != nil {
+		bmxConfig.HTTPClient = &gohttp.Client{
+			Transport: c.httpTransport(),
+			Timeout:   c.BluemixTimeout,
+		}
+	}
 	sess, err = bxsession.New(bmxConfig)
 	if err != nil {
 		return nil, fileMap, err
@@ -4141,6 +5164,7 @@ func authenticateAssume(sess *bxsession.Session) error {
 func fetchAuthorizationData(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		HTTPClient: config.HTTPClient,
 		DefaultHeader: gohttp.Header{
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
@@ -4196,6 +5220,7 @@ func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Dura
 func RefreshToken(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		HTTPClient: config.HTTPClient,
 		DefaultHeader: gohttp.Header{
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
@@ -4217,31 +5242,27 @@ func EnvFallBack(envs []string, defaultValue string) string {
 	return defaultValue
 }
 
-func FileFallBack(endpointsFile, visibility, key, region, defaultValue string) string {
+// FileFallBack returns the endpoint of key for the visibility and region in the
+// endpoints file, or defaultValue if the file does not give one. It returns an
+// error if the endpoints file cannot be read or is invalid.
+func FileFallBack(endpointsFile, visibility, key, region, defaultValue string) (string, error) {
 	var fileMap map[string]interface{}
 	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, endpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
-		}
-		defer jsonFile.Close()
-		bytes, err := io.ReadAll(jsonFile)
-		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
-		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
+		var err error
+		fileMap, err = LoadEndpointsFile(f)
 		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
+			return defaultValue, err
 		}
 	}
 
-	return fileFallBack(fileMap, visibility, key, region, defaultValue)
+	return fileFallBack(fileMap, visibility, key, region, defaultValue), nil
 }
 
 func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
 	if val, ok := fileMap[key]; ok {
 		if v, ok := val.(map[string]interface{})[visibility]; ok {
 			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
+				log.Printf("[INFO] Resolved %s from the Endpoints File (visibility %s, region %s)", key, visibility, region)
 				return r.(string)
 			}
 		}
@@ -4249,6 +5270,65 @@ func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defau
 	return defaultValue
 }
 
+// setHTTPTransport sends the requests of a go-sdk-core service through the
+// rate limiter of the session, and through HTTPTransport if it is set.
+func (c *Config) setHTTPTransport(service *core.BaseService) {
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+		service.SetHTTPClient(client)
+	}
+	transport := client.Transport
+	if c.HTTPTransport != nil {
+		transport = c.HTTPTransport
+	}
+	if c.rateLimiter != nil {
+		// The services retry rate limited calls themselves (EnableRetries)
+		transport = c.rateLimiter.transport(transport, false)
+	}
+	client.Transport = transport
+	log.Printf("[DEBUG] Configured service endpoint %s", service.GetServiceURL())
+}
+
+// httpTransport returns the transport of the HTTP clients that are not built
+// on go-sdk-core and retry rate limited calls themselves, such as the Bluemix
+// and Key Protect clients: HTTPTransport, or DefaultTransport if it is not
+// set, behind the rate limiter of the session.
+func (c *Config) httpTransport() gohttp.RoundTripper {
+	return c.limitedTransport(false)
+}
+
+// retryingHTTPTransport returns the transport of the HTTP clients that do not
+// retry rate limited calls, such as the IAM authenticators and the Power
+// client. It is httpTransport, retrying the calls rate limited with HTTP 429.
+func (c *Config) retryingHTTPTransport() gohttp.RoundTripper {
+	return c.limitedTransport(true)
+}
+
+func (c *Config) limitedTransport(retry bool) gohttp.RoundTripper {
+	transport := c.HTTPTransport
+	if transport == nil {
+		transport = DefaultTransport()
+	}
+	if c.rateLimiter != nil {
+		transport = c.rateLimiter.transport(transport, retry)
+	}
+	return transport
+}
+
+// authHTTPClient returns the HTTP client of the IAM authenticators, which
+// send their token requests through retryingHTTPTransport. It is nil, so that the
+// authenticators use their default client, when no custom transport is set.
+func (c *Config) authHTTPClient() *gohttp.Client {
+	if c.HTTPTransport == nil && c.rateLimiter == nil {
+		return nil
+	}
+	return &gohttp.Client{
+		Transport: c.retryingHTTPTransport(),
+		Timeout:   30 * time.Second,
+	}
+}
+
 // DefaultTransport ...
 func DefaultTransport() gohttp.RoundTripper {
 	transport := &gohttp.Transport{
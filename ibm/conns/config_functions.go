@@ -27,7 +27,7 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 		return nil, err
 	}
 
-	functionsClient, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	functionsClient, err := whisk.NewClient(functionHTTPClient(c), &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -35,6 +35,15 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 	return functionsClient, err
 }
 
+// functionHTTPClient returns the HTTP client of the session, which is set when
+// the provider uses a custom transport, or the default client.
+func functionHTTPClient(c *bluemix.Config) *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
 // getBaseURL ..
 func getBaseURL(region string) string {
 	baseEndpoint := fmt.Sprintf(DefaultServiceURL)
@@ -54,7 +63,7 @@ func getBaseURL(region string) string {
  */
 func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, functionNamespace functions.FunctionServiceAPI) (*whisk.Client, error) {
 	u, _ := url.Parse(fmt.Sprintf("https://%s.functions.cloud.ibm.com/api", sess.Config.Region))
-	wskClient, _ := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	wskClient, _ := whisk.NewClient(functionHTTPClient(sess.Config), &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
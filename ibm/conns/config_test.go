@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	gohttp "net/http"
+	"sync"
+	"testing"
+
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestClientSessionConfigureOnce(t *testing.T) {
+	session := &clientSession{
+		session:    &Session{BluemixSession: &bxsession.Session{}},
+		configured: map[string]*sync.Once{},
+	}
+
+	calls := 0
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			session.configure("foo", func() {
+				calls++
+			})
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("Expected configure function to run once, ran %d times", calls)
+	}
+}
+
+func TestClientSessionConfigureWithoutCredentials(t *testing.T) {
+	session := &clientSession{
+		session:    &Session{},
+		configured: map[string]*sync.Once{},
+	}
+	session.vpcErr = errEmptyBluemixCredentials
+
+	session.configure("Vpc", func() {
+		t.Fatal("Clients should not be configured without IBM Cloud credentials")
+	})
+
+	if _, err := session.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("Expected %q, got %v", errEmptyBluemixCredentials, err)
+	}
+}
+
+type testTransport struct{}
+
+func (testTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	return nil, nil
+}
+
+func TestConfigHTTPTransport(t *testing.T) {
+	c := &Config{}
+	if _, ok := c.httpTransport().(*gohttp.Transport); !ok {
+		t.Fatalf("Expected the default transport, got %T", c.httpTransport())
+	}
+	if c.authHTTPClient() != nil {
+		t.Fatal("Expected the IAM authenticators to use their default client")
+	}
+
+	c.HTTPTransport = testTransport{}
+	if _, ok := c.httpTransport().(testTransport); !ok {
+		t.Fatalf("Expected HTTPTransport, got %T", c.httpTransport())
+	}
+
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           "https://us-south.iaas.cloud.ibm.com/v1",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.setHTTPTransport(service)
+	if _, ok := service.GetHTTPClient().Transport.(testTransport); !ok {
+		t.Fatalf("Expected the service to use HTTPTransport, got %T", service.GetHTTPClient().Transport)
+	}
+	if _, ok := c.authHTTPClient().Transport.(testTransport); !ok {
+		t.Fatalf("Expected the IAM authenticators to use HTTPTransport, got %T", c.authHTTPClient().Transport)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+)
+
+// EndpointsFileVersion is the version of the endpoints file format described
+// by endpoints_file_schema.json. Files without a version use the original
+// format, which has the same layout but is not strictly validated.
+const EndpointsFileVersion = 2
+
+// endpointVisibilities are the visibilities an endpoint can be given for.
+var endpointVisibilities = []string{"public", "private", "public-and-private"}
+
+// endpointVariables are the services that can be given an endpoint in the
+// endpoints file, by the name of the environment variable that overrides it.
+// Only the variables that the provider reads from the endpoints file are
+// listed; the other endpoint variables can only be set in the environment.
+var endpointVariables = []string{
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_APP_CONFIG_ENDPOINT",
+	"IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT",
+	"IBMCLOUD_BACKUP_RECOVERY_ENDPOINT",
+	"IBMCLOUD_BACKUP_RECOVERY_MANAGER_API_KEY",
+	"IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT",
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_CIS_API_ENDPOINT",
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"IBMCLOUD_CODE_ENGINE_API_ENDPOINT",
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"IBMCLOUD_COS_ENDPOINT",
+	"IBMCLOUD_CR_API_ENDPOINT",
+	"IBMCLOUD_DATABASES_API_ENDPOINT",
+	"IBMCLOUD_DB2_API_ENDPOINT",
+	"IBMCLOUD_DL_API_ENDPOINT",
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"IBMCLOUD_GS_API_ENDPOINT",
+	"IBMCLOUD_GT_API_ENDPOINT",
+	"IBMCLOUD_HPCS_TKE_ENDPOINT",
+	"IBMCLOUD_IAM_API_ENDPOINT",
+	"IBMCLOUD_IS_NG_API_ENDPOINT",
+	"IBMCLOUD_KP_API_ENDPOINT",
+	"IBMCLOUD_LOGS_API_ENDPOINT",
+	"IBMCLOUD_LOGS_ROUTING_API_ENDPOINT",
+	"IBMCLOUD_LOGS_ROUTING_API_ENDPOINT_V3",
+	"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT",
+	"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT",
+	"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT",
+	"IBMCLOUD_PI_API_ENDPOINT",
+	"IBMCLOUD_PLATFORM_NOTIFICATIONS_API_ENDPOINT",
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"IBMCLOUD_PROJECT_API_ENDPOINT",
+	"IBMCLOUD_PUSH_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"IBMCLOUD_SCC_API_ENDPOINT",
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+	"IBMCLOUD_TG_API_ENDPOINT",
+	"IBMCLOUD_TOOLCHAIN_ENDPOINT",
+	"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT",
+	"IBMCLOUD_VMWARE_URL",
+}
+
+// LoadEndpointsFile reads the endpoints file at path and returns its endpoints
+// by service, visibility and region. A versioned file is rejected if it does
+// not match endpoints_file_schema.json.
+func LoadEndpointsFile(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read Endpoints File %s: %s", path, err)
+	}
+	fileMap, err := parseEndpointsFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid Endpoints File %s: %s", path, err)
+	}
+	return fileMap, nil
+}
+
+func parseEndpointsFile(data []byte) (map[string]interface{}, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	version := 1
+	if value, ok := raw["version"]; ok {
+		if err := json.Unmarshal(value, &version); err != nil || version != EndpointsFileVersion {
+			return nil, fmt.Errorf("unsupported version %s, expected %d", value, EndpointsFileVersion)
+		}
+	}
+
+	// Files without a version are accepted as before: their problems are only
+	// logged, and the entries with problems are ignored.
+	var errs []string
+	problem := func(format string, a ...interface{}) {
+		if version < EndpointsFileVersion {
+			log.Printf("[WARN] Endpoints File: "+format, a...)
+			return
+		}
+		errs = append(errs, fmt.Sprintf(format, a...))
+	}
+
+	fileMap := make(map[string]interface{}, len(raw))
+	for _, key := range sortedKeys(raw) {
+		if key == "version" || key == "$schema" {
+			continue
+		}
+		if !isEndpointVariable(key) {
+			if suggestion := closestEndpointVariable(key); suggestion != "" {
+				problem("unknown service %q, did you mean %q?", key, suggestion)
+			} else {
+				problem("unknown service %q", key)
+			}
+			continue
+		}
+
+		var endpoints map[string]map[string]string
+		if err := json.Unmarshal(raw[key], &endpoints); err != nil {
+			problem("%s must map visibilities to regions and endpoints: %s", key, err)
+			continue
+		}
+		visibilities := make(map[string]interface{}, len(endpoints))
+		for visibility, regions := range endpoints {
+			if !isEndpointVisibility(visibility) {
+				problem("%s: unknown visibility %q, expected one of %s", key, visibility, strings.Join(endpointVisibilities, ", "))
+				continue
+			}
+			endpointsByRegion := make(map[string]interface{}, len(regions))
+			for region, endpoint := range regions {
+				if err := validateEndpoint(region, endpoint); err != nil {
+					problem("%s.%s.%s: %s", key, visibility, region, err)
+					continue
+				}
+				endpointsByRegion[region] = endpoint
+			}
+			visibilities[visibility] = endpointsByRegion
+		}
+		fileMap[key] = visibilities
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return fileMap, nil
+}
+
+// validateEndpoint checks the endpoint of a region. Endpoints are URLs, or host
+// names for the services that are given one, such as Object Storage.
+func validateEndpoint(region, endpoint string) error {
+	if region == "" {
+		return fmt.Errorf("empty region")
+	}
+	if endpoint == "" || strings.ContainsAny(endpoint, " \t\r\n") {
+		return fmt.Errorf("invalid endpoint %q", endpoint)
+	}
+	if !strings.Contains(endpoint, "://") {
+		return nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("%q is not an http or https URL", endpoint)
+	}
+	return nil
+}
+
+func isEndpointVariable(key string) bool {
+	for _, variable := range endpointVariables {
+		if variable == key {
+			return true
+		}
+	}
+	return false
+}
+
+func isEndpointVisibility(visibility string) bool {
+	for _, v := range endpointVisibilities {
+		if v == visibility {
+			return true
+		}
+	}
+	return false
+}
+
+// closestEndpointVariable returns the known service closest to key, if it is
+// close enough to be a typo.
+func closestEndpointVariable(key string) string {
+	closest, distance := "", 4
+	for _, variable := range endpointVariables {
+		if d := editDistance(strings.ToUpper(key), variable); d < distance {
+			closest, distance = variable, d
+		}
+	}
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func sortedKeys(m map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
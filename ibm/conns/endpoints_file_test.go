@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestLoadEndpointsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	data := `{
+		"$schema": "https://raw.githubusercontent.com/IBM-Cloud/terraform-provider-ibm/master/ibm/conns/endpoints_file_schema.json",
+		"version": 2,
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {
+			"public": {"us-south": "https://us-south.iaas.cloud.ibm.com/v1"},
+			"public-and-private": {"us-south": "https://us-south.private.iaas.cloud.ibm.com/v1"}
+		},
+		"IBMCLOUD_COS_ENDPOINT": {
+			"private": {"us-south": "s3.direct.us-south.cloud-object-storage.appdomain.cloud"}
+		}
+	}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fileMap, err := LoadEndpointsFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		visibility, key, expected string
+	}{
+		{"public", "IBMCLOUD_IS_NG_API_ENDPOINT", "https://us-south.iaas.cloud.ibm.com/v1"},
+		{"public-and-private", "IBMCLOUD_IS_NG_API_ENDPOINT", "https://us-south.private.iaas.cloud.ibm.com/v1"},
+		{"private", "IBMCLOUD_IS_NG_API_ENDPOINT", "default"},
+		{"private", "IBMCLOUD_COS_ENDPOINT", "s3.direct.us-south.cloud-object-storage.appdomain.cloud"},
+	} {
+		if endpoint := fileFallBack(fileMap, test.visibility, test.key, "us-south", "default"); endpoint != test.expected {
+			t.Errorf("Expected %s for %s and visibility %s, got %s", test.expected, test.key, test.visibility, endpoint)
+		}
+	}
+	if _, ok := fileMap["version"]; ok {
+		t.Errorf("Expected version not to be returned as an endpoint")
+	}
+}
+
+func TestParseEndpointsFileErrors(t *testing.T) {
+	for _, test := range []struct {
+		data     string
+		expected string
+	}{
+		{`{"version": 3}`, "unsupported version 3"},
+		{`{"version": 2, "IBMCLOUD_IS_NG_API_ENDPIONT": {}}`, `unknown service "IBMCLOUD_IS_NG_API_ENDPIONT", did you mean "IBMCLOUD_IS_NG_API_ENDPOINT"?`},
+		{`{"version": 2, "VPC": {}}`, `unknown service "VPC"`},
+		{`{"version": 2, "IBMCLOUD_TG_API_ENDPOINT": "https://transit.cloud.ibm.com/v1"}`, "IBMCLOUD_TG_API_ENDPOINT must map visibilities to regions and endpoints"},
+		{`{"version": 2, "IBMCLOUD_TG_API_ENDPOINT": {"direct": {"us-south": "https://transit.cloud.ibm.com/v1"}}}`, `IBMCLOUD_TG_API_ENDPOINT: unknown visibility "direct"`},
+		{`{"version": 2, "IBMCLOUD_TG_API_ENDPOINT": {"public": {"us-south": "ftp://transit.cloud.ibm.com"}}}`, "IBMCLOUD_TG_API_ENDPOINT.public.us-south: \"ftp://transit.cloud.ibm.com\" is not an http or https URL"},
+		{`{"version": 2, "IBMCLOUD_TG_API_ENDPOINT": {"public": {"us-south": ""}}}`, `IBMCLOUD_TG_API_ENDPOINT.public.us-south: invalid endpoint ""`},
+	} {
+		_, err := parseEndpointsFile([]byte(test.data))
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("Expected error %q for %s, got %v", test.expected, test.data, err)
+		}
+	}
+}
+
+func TestParseEndpointsFileWithoutVersion(t *testing.T) {
+	data := `{
+		"IBMCLOUD_IS_NG_ENDPOINT": {"public": {"us-south": "https://us-south.iaas.cloud.ibm.com/v1"}},
+		"IBMCLOUD_TG_API_ENDPOINT": {"public": {"us-south": "https://transit.cloud.ibm.com/v1", "eu-de": "not a url"}}
+	}`
+	fileMap, err := parseEndpointsFile([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fileMap["IBMCLOUD_IS_NG_ENDPOINT"]; ok {
+		t.Errorf("Expected the unknown service to be ignored")
+	}
+	if endpoint := fileFallBack(fileMap, "public", "IBMCLOUD_TG_API_ENDPOINT", "us-south", "default"); endpoint != "https://transit.cloud.ibm.com/v1" {
+		t.Errorf("Expected the valid endpoint to be kept, got %s", endpoint)
+	}
+	if endpoint := fileFallBack(fileMap, "public", "IBMCLOUD_TG_API_ENDPOINT", "eu-de", "default"); endpoint != "default" {
+		t.Errorf("Expected the invalid endpoint to be ignored, got %s", endpoint)
+	}
+}
+
+func TestEndpointsFileSchema(t *testing.T) {
+	data, err := os.ReadFile("endpoints_file_schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	if err = json.Unmarshal(data, &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	var services []string
+	for key := range schema.Properties {
+		if key != "version" && key != "$schema" {
+			services = append(services, key)
+		}
+	}
+	sort.Strings(services)
+	if !reflect.DeepEqual(services, endpointVariables) {
+		t.Fatalf("Expected the services of endpoints_file_schema.json to be %v, got %v", endpointVariables, services)
+	}
+}
+
+func TestFileFallBackInvalidFile(t *testing.T) {
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", "")
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := os.WriteFile(path, []byte(`{"version": 2, "VPC": {}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint, err := FileFallBack(path, "public", "IBMCLOUD_IS_NG_API_ENDPOINT", "us-south", "default")
+	if err == nil || !strings.Contains(err.Error(), `unknown service "VPC"`) {
+		t.Errorf("Expected the invalid endpoints file to be reported, got %v", err)
+	}
+	if endpoint != "default" {
+		t.Errorf("Expected the default endpoint, got %s", endpoint)
+	}
+}
+
+// TestEndpointVariablesAreRead checks that the provider reads every service of
+// the endpoints file from it, so that no entry of the file is silently unused.
+func TestEndpointVariablesAreRead(t *testing.T) {
+	read := map[string]bool{}
+	err := filepath.Walk("..", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if !strings.Contains(line, "ileFallBack(") {
+				continue
+			}
+			for _, variable := range endpointVariables {
+				if strings.Contains(line, `"`+variable+`"`) {
+					read[variable] = true
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, variable := range endpointVariables {
+		if !read[variable] {
+			t.Errorf("Expected %s to be read from the endpoints file", variable)
+		}
+	}
+}
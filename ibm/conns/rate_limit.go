@@ -0,0 +1,284 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io"
+	"log"
+	"math"
+	gohttp "net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultMaxRetryDelay is the longest wait between two attempts of a rate
+// limited API call when max_retry_delay is not set.
+const DefaultMaxRetryDelay = 60 * time.Second
+
+// rateLimiter limits the API calls of all the HTTP clients of a session. The
+// number of requests per second is limited per service endpoint, the number of
+// requests in progress for the whole session. Rate limited calls (HTTP 429)
+// pause the other calls to the same endpoint until their backoff, which honors
+// Retry-After, has elapsed. They are retried by the transport unless the client
+// retries them itself, so that the attempts of the two do not multiply.
+//
+// The SDK and the framework providers configure their own session, and share
+// the rate limiter through sharedRateLimiter so that the limits apply to the
+// whole provider.
+type rateLimiter struct {
+	requestsPerSecond float64
+	// inFlight holds a token per request in progress; nil if unlimited.
+	inFlight chan struct{}
+
+	retryCount    int
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
+
+	mu        sync.Mutex
+	endpoints map[string]*endpointLimiter
+}
+
+// endpointLimiter is the state of the rate limiter for one service endpoint.
+type endpointLimiter struct {
+	limiter *rate.Limiter
+	// pausedUntil is the end of the backoff of the last rate limited call.
+	pausedUntil time.Time
+}
+
+// rateLimiterSettings identifies the rate limiter of a configuration.
+type rateLimiterSettings struct {
+	requestsPerSecond   float64
+	maxInFlightRequests int
+	retryCount          int
+	retryDelay          time.Duration
+	maxRetryDelay       time.Duration
+}
+
+var (
+	rateLimitersMu sync.Mutex
+	rateLimiters   = map[rateLimiterSettings]*rateLimiter{}
+)
+
+// sharedRateLimiter returns the rate limiter of the sessions configured with
+// the settings of c, and creates it on the first call. It returns nil if no
+// limit is configured, so that the HTTP clients keep their own transport.
+func sharedRateLimiter(c *Config) *rateLimiter {
+	if c.RequestsPerSecond <= 0 && c.MaxInFlightRequests <= 0 {
+		return nil
+	}
+	settings := rateLimiterSettings{
+		requestsPerSecond:   c.RequestsPerSecond,
+		maxInFlightRequests: c.MaxInFlightRequests,
+		retryCount:          c.RetryCount,
+		retryDelay:          c.RetryDelay,
+		maxRetryDelay:       c.MaxRetryDelay,
+	}
+
+	rateLimitersMu.Lock()
+	defer rateLimitersMu.Unlock()
+	l, ok := rateLimiters[settings]
+	if !ok {
+		l = newRateLimiter(c)
+		rateLimiters[settings] = l
+	}
+	return l
+}
+
+func newRateLimiter(c *Config) *rateLimiter {
+	l := &rateLimiter{
+		requestsPerSecond: c.RequestsPerSecond,
+		retryCount:        c.RetryCount,
+		retryDelay:        c.RetryDelay,
+		maxRetryDelay:     c.MaxRetryDelay,
+		endpoints:         map[string]*endpointLimiter{},
+	}
+	if c.MaxInFlightRequests > 0 {
+		l.inFlight = make(chan struct{}, c.MaxInFlightRequests)
+	}
+	if l.retryDelay <= 0 {
+		l.retryDelay = time.Second
+	}
+	if l.maxRetryDelay <= 0 {
+		l.maxRetryDelay = DefaultMaxRetryDelay
+	}
+	return l
+}
+
+// transport returns a transport sending requests through base under the
+// limits of the rate limiter. It retries rate limited calls if retry is set;
+// otherwise it returns them to the client, which must retry them itself.
+func (l *rateLimiter) transport(base gohttp.RoundTripper, retry bool) gohttp.RoundTripper {
+	if base == nil {
+		base = gohttp.DefaultTransport
+	}
+	return &rateLimitedTransport{
+		limiter: l,
+		base:    base,
+		retry:   retry,
+	}
+}
+
+func (l *rateLimiter) endpoint(host string) *endpointLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e, ok := l.endpoints[host]
+	if !ok {
+		e = &endpointLimiter{}
+		if l.requestsPerSecond > 0 {
+			e.limiter = rate.NewLimiter(rate.Limit(l.requestsPerSecond), int(math.Ceil(l.requestsPerSecond)))
+		}
+		l.endpoints[host] = e
+	}
+	return e
+}
+
+// wait blocks until a request can be sent to the endpoint of req, and returns
+// the function releasing its in-flight slot.
+func (l *rateLimiter) wait(req *gohttp.Request) (func(), error) {
+	ctx := req.Context()
+	e := l.endpoint(req.URL.Host)
+
+	l.mu.Lock()
+	pause := time.Until(e.pausedUntil)
+	l.mu.Unlock()
+	if pause > 0 {
+		if err := sleep(req, pause); err != nil {
+			return nil, err
+		}
+	}
+
+	if e.limiter != nil {
+		if err := e.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if l.inFlight == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.inFlight <- struct{}{}:
+		return func() { <-l.inFlight }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// backoff returns the delay before the given retry of a rate limited call,
+// and pauses the endpoint of the call for that long.
+func (l *rateLimiter) backoff(resp *gohttp.Response, retry int) time.Duration {
+	delay, ok := retryAfter(resp)
+	if !ok {
+		delay = l.maxRetryDelay
+		if retry < 30 {
+			delay = l.retryDelay << uint(retry)
+		}
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	if delay > l.maxRetryDelay {
+		delay = l.maxRetryDelay
+	}
+
+	e := l.endpoint(resp.Request.URL.Host)
+	l.mu.Lock()
+	if until := time.Now().Add(delay); until.After(e.pausedUntil) {
+		e.pausedUntil = until
+	}
+	l.mu.Unlock()
+	return delay
+}
+
+// rateLimitedTransport is the transport of the HTTP clients of a session.
+type rateLimitedTransport struct {
+	limiter *rateLimiter
+	base    gohttp.RoundTripper
+	// retry is set if the client does not retry rate limited calls itself.
+	retry bool
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	for retry := 0; ; retry++ {
+		release, err := t.limiter.wait(req)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := t.base.RoundTrip(req)
+		release()
+		if err != nil || resp.StatusCode != gohttp.StatusTooManyRequests {
+			return resp, err
+		}
+		if !t.retry {
+			t.limiter.backoff(resp, 0)
+			return resp, err
+		}
+		if retry >= t.limiter.retryCount {
+			return resp, err
+		}
+		// The request can only be sent again if its body can be rewound
+		if req.Body != nil && req.Body != gohttp.NoBody && req.GetBody == nil {
+			return resp, err
+		}
+
+		delay := t.limiter.backoff(resp, retry)
+		log.Printf("[DEBUG] %s %s was rate limited, retrying in %s", req.Method, req.URL.Redacted(), delay)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if err = sleep(req, delay); err != nil {
+			return nil, err
+		}
+
+		req, err = rewind(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rewind returns a copy of req with a new body, to send it again.
+func rewind(req *gohttp.Request) (*gohttp.Request, error) {
+	if req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	retry := req.Clone(req.Context())
+	retry.Body = body
+	return retry, nil
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp,
+// given either in seconds or as an HTTP date.
+func retryAfter(resp *gohttp.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := gohttp.ParseTime(value); err == nil {
+		return time.Until(date), true
+	}
+	return 0, false
+}
+
+// sleep waits for d, or until the context of req is done.
+func sleep(req *gohttp.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	gohttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterRetriesRateLimitedCalls(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		body := make([]byte, 5)
+		r.Body.Read(body)
+		if string(body) != "hello" {
+			t.Errorf("Expected the request body to be sent again, got %q", body)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(gohttp.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(gohttp.StatusOK)
+	}))
+	defer server.Close()
+
+	c := &Config{RetryCount: 3, RetryDelay: time.Millisecond}
+	client := &gohttp.Client{Transport: newRateLimiter(c).transport(nil, true)}
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != gohttp.StatusOK || calls != 3 {
+		t.Fatalf("Expected status 200 after 3 calls, got %d after %d calls", resp.StatusCode, calls)
+	}
+}
+
+func TestRateLimiterStopsRetrying(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(gohttp.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := &Config{RetryCount: 2, RetryDelay: time.Millisecond}
+	client := &gohttp.Client{Transport: newRateLimiter(c).transport(nil, true)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != gohttp.StatusTooManyRequests || calls != 3 {
+		t.Fatalf("Expected status 429 after 3 calls, got %d after %d calls", resp.StatusCode, calls)
+	}
+}
+
+func TestRateLimiterLeavesRetriesToClient(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(gohttp.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := &Config{RetryCount: 3, RetryDelay: time.Millisecond}
+	l := newRateLimiter(c)
+	client := &gohttp.Client{Transport: l.transport(nil, false)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != gohttp.StatusTooManyRequests || calls != 1 {
+		t.Fatalf("Expected status 429 after 1 call, got %d after %d calls", resp.StatusCode, calls)
+	}
+	if e := l.endpoint(resp.Request.URL.Host); time.Until(e.pausedUntil) <= 0 {
+		t.Fatal("Expected the endpoint to be paused after a rate limited call")
+	}
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	sdk := &Config{RequestsPerSecond: 7, RetryCount: 10}
+	framework := &Config{RequestsPerSecond: 7, RetryCount: 10}
+	if sharedRateLimiter(sdk) != sharedRateLimiter(framework) {
+		t.Fatal("Expected the sessions of the same configuration to share the rate limiter")
+	}
+	if sharedRateLimiter(sdk) == sharedRateLimiter(&Config{RequestsPerSecond: 8, RetryCount: 10}) {
+		t.Fatal("Expected a different configuration to have its own rate limiter")
+	}
+	if sharedRateLimiter(&Config{RetryCount: 10}) != nil {
+		t.Fatal("Expected no rate limiter when no limit is configured")
+	}
+}
+
+func TestRateLimiterMaxInFlightRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	c := &Config{MaxInFlightRequests: 2}
+	client := &gohttp.Client{Transport: newRateLimiter(c).transport(nil, true)}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+	if maxInFlight > 2 {
+		t.Fatalf("Expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestRateLimiterBackoff(t *testing.T) {
+	c := &Config{RetryDelay: time.Second, MaxRetryDelay: 10 * time.Second}
+	l := newRateLimiter(c)
+	req := httptest.NewRequest(gohttp.MethodGet, "https://us-south.iaas.cloud.ibm.com/v1/vpcs", nil)
+
+	for _, test := range []struct {
+		retryAfter string
+		retry      int
+		expected   time.Duration
+	}{
+		{"", 0, time.Second},
+		{"", 2, 4 * time.Second},
+		{"", 5, 10 * time.Second},
+		{"3", 5, 3 * time.Second},
+		{"120", 0, 10 * time.Second},
+		{"Mon, 02 Jan 2006 15:04:05 GMT", 0, 0},
+	} {
+		resp := &gohttp.Response{Header: gohttp.Header{}, Request: req}
+		if test.retryAfter != "" {
+			resp.Header.Set("Retry-After", test.retryAfter)
+		}
+		if delay := l.backoff(resp, test.retry); delay != test.expected {
+			t.Errorf("Expected a delay of %s for Retry-After %q and retry %d, got %s", test.expected, test.retryAfter, test.retry, delay)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SetResourceIdentity sets the identity attributes of a resource that has just
+// been read. Nothing is set when the read removed the resource from the state.
+func SetResourceIdentity(d *schema.ResourceData, attributes map[string]interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("Error getting identity: %s", err)
+	}
+	for key, value := range attributes {
+		if err = identity.Set(key, value); err != nil {
+			return fmt.Errorf("Error setting identity %s: %s", key, err)
+		}
+	}
+	return nil
+}
+
+// SetResourceIdentityFromID sets the identity of a resource whose ID joins the
+// values of the given identity attributes with separator, for example
+// "<vpc>/<routing_table>". A single attribute is set to the whole ID. An ID in
+// another format, for example one written by an older version of the provider,
+// is logged and leaves the identity unset so that Read does not fail.
+func SetResourceIdentityFromID(d *schema.ResourceData, separator string, attributes ...string) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	parts := []string{d.Id()}
+	if len(attributes) > 1 {
+		parts = strings.SplitN(d.Id(), separator, len(attributes))
+	}
+	if len(parts) != len(attributes) {
+		log.Printf("[WARN] Not setting the identity of %s: ID should be a combination of %s", d.Id(), strings.Join(attributes, separator))
+		return nil
+	}
+
+	values := make(map[string]interface{}, len(attributes))
+	for i, attribute := range attributes {
+		values[attribute] = parts[i]
+	}
+	return SetResourceIdentity(d, values)
+}
+
+// SetIDFromIdentity sets the ID of a resource imported by identity by joining
+// the values of the given identity attributes with separator. Nothing is done
+// when the resource is imported by ID.
+func SetIDFromIdentity(d *schema.ResourceData, separator string, attributes ...string) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("Error getting identity: %s", err)
+	}
+	parts := make([]string, 0, len(attributes))
+	for _, attribute := range attributes {
+		value, ok := identity.GetOk(attribute)
+		if !ok {
+			return fmt.Errorf("Expected identity to contain %s", attribute)
+		}
+		part, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("Expected identity %s to be a string, got %T", attribute, value)
+		}
+		parts = append(parts, part)
+	}
+	d.SetId(strings.Join(parts, separator))
+	return nil
+}
+
+// ImportStateWithIdentity returns an import function for resources whose ID
+// joins the values of the given identity attributes with separator. Resources
+// imported by ID are passed through unchanged.
+func ImportStateWithIdentity(separator string, attributes ...string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if err := SetIDFromIdentity(d, separator, attributes...); err != nil {
+			return nil, err
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
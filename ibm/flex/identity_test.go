@@ -0,0 +1,94 @@
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testIdentitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"vpc": {
+			Type:              schema.TypeString,
+			RequiredForImport: true,
+		},
+		"routing_table": {
+			Type:              schema.TypeString,
+			RequiredForImport: true,
+		},
+	}
+}
+
+func testIdentityResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: testIdentitySchema,
+		},
+	}
+}
+
+func TestSetResourceIdentityFromID(t *testing.T) {
+	d := testIdentityResource().Data(nil)
+	d.SetId("r006-vpc/r006-table")
+
+	assert.NoError(t, SetResourceIdentityFromID(d, "/", "vpc", "routing_table"))
+	identity, err := d.Identity()
+	assert.NoError(t, err)
+	assert.Equal(t, "r006-vpc", identity.Get("vpc"))
+	assert.Equal(t, "r006-table", identity.Get("routing_table"))
+}
+
+func TestSetResourceIdentityFromIDInvalid(t *testing.T) {
+	d := testIdentityResource().Data(nil)
+	d.SetId("r006-vpc")
+
+	assert.NoError(t, SetResourceIdentityFromID(d, "/", "vpc", "routing_table"))
+	identity, err := d.Identity()
+	assert.NoError(t, err)
+	assert.Equal(t, "", identity.Get("vpc"))
+	assert.Equal(t, "", identity.Get("routing_table"))
+}
+
+func TestSetResourceIdentityFromIDRemoved(t *testing.T) {
+	d := testIdentityResource().Data(nil)
+
+	assert.NoError(t, SetResourceIdentityFromID(d, "/", "vpc", "routing_table"))
+}
+
+func TestImportStateWithIdentity(t *testing.T) {
+	d := schema.TestResourceDataWithIdentityRaw(t, testIdentityResource().Schema, testIdentitySchema(), map[string]string{
+		"vpc":           "r006-vpc",
+		"routing_table": "r006-table",
+	})
+
+	result, err := ImportStateWithIdentity("/", "vpc", "routing_table")(context.Background(), d, nil)
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "r006-vpc/r006-table", result[0].Id())
+}
+
+func TestImportStateWithIdentityByID(t *testing.T) {
+	d := testIdentityResource().Data(nil)
+	d.SetId("r006-vpc/r006-table")
+
+	result, err := ImportStateWithIdentity("/", "vpc", "routing_table")(context.Background(), d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "r006-vpc/r006-table", result[0].Id())
+}
+
+func TestImportStateWithIdentityMissingAttribute(t *testing.T) {
+	d := schema.TestResourceDataWithIdentityRaw(t, testIdentityResource().Schema, testIdentitySchema(), map[string]string{
+		"vpc": "r006-vpc",
+	})
+
+	_, err := ImportStateWithIdentity("/", "vpc", "routing_table")(context.Background(), d, nil)
+	assert.Error(t, err)
+}
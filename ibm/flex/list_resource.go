@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ListResourceRawV6Schemas sets the resource and identity schemas of an SDKv2
+// resource on the response, so that a framework list resource can return
+// results for it. The resource must define an Identity.
+func ListResourceRawV6Schemas(ctx context.Context, r *schema.Resource, resp *list.RawV6SchemaResponse) {
+	resp.ProtoV6Schema = protoV5ToV6Schema(r.ProtoSchema(ctx)())
+	if identitySchema := r.ProtoIdentitySchema(ctx); identitySchema != nil {
+		resp.ProtoV6IdentitySchema = protoV5ToV6IdentitySchema(identitySchema())
+	}
+}
+
+// ListResourceFromRead reads the SDKv2 resource with the given id through its
+// own read function and stores the resulting state in the list result
+// resource, so that it matches what an import of the same id would produce.
+// It returns false if the resource no longer exists.
+func ListResourceFromRead(ctx context.Context, r *schema.Resource, meta interface{}, id string, result *list.ListResult) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	d := r.Data(nil)
+	d.SetId(id)
+	if readDiags := r.ReadContext(ctx, d, meta); readDiags.HasError() {
+		for _, readDiag := range readDiags {
+			diags.AddError(readDiag.Summary, readDiag.Detail)
+		}
+		return false, diags
+	}
+
+	state := d.State()
+	if state == nil || d.Id() == "" {
+		return false, diags
+	}
+
+	ty := r.CoreConfigSchema().ImpliedType()
+	val, err := state.AttrsAsObjectValue(ty)
+	if err != nil {
+		diags.AddError("Unable to Convert Resource State", fmt.Sprintf("Error converting the state of %s: %s", id, err))
+		return false, diags
+	}
+	js, err := ctyjson.Marshal(val, ty)
+	if err != nil {
+		diags.AddError("Unable to Convert Resource State", fmt.Sprintf("Error encoding the state of %s: %s", id, err))
+		return false, diags
+	}
+	raw, err := tftypes.ValueFromJSONWithOpts(js, result.Resource.Schema.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{
+		IgnoreUndefinedAttributes: true,
+	})
+	if err != nil {
+		diags.AddError("Unable to Convert Resource State", fmt.Sprintf("Error decoding the state of %s: %s", id, err))
+		return false, diags
+	}
+
+	result.Resource = &tfsdk.Resource{
+		Raw:    raw,
+		Schema: result.Resource.Schema,
+	}
+	return true, diags
+}
+
+// protoV5ToV6Schema converts the protocol 5 schema generated by the SDK into
+// its protocol 6 equivalent. Protocol 5 has no nested attribute types, so the
+// conversion is a field by field copy.
+func protoV5ToV6Schema(in *tfprotov5.Schema) *tfprotov6.Schema {
+	if in == nil {
+		return nil
+	}
+	return &tfprotov6.Schema{
+		Version: in.Version,
+		Block:   protoV5ToV6SchemaBlock(in.Block),
+	}
+}
+
+func protoV5ToV6SchemaBlock(in *tfprotov5.SchemaBlock) *tfprotov6.SchemaBlock {
+	if in == nil {
+		return nil
+	}
+	out := &tfprotov6.SchemaBlock{
+		Version:            in.Version,
+		Description:        in.Description,
+		DescriptionKind:    tfprotov6.StringKind(in.DescriptionKind),
+		Deprecated:         in.Deprecated,
+		DeprecationMessage: in.DeprecationMessage,
+	}
+	for _, attr := range in.Attributes {
+		out.Attributes = append(out.Attributes, &tfprotov6.SchemaAttribute{
+			Name:               attr.Name,
+			Type:               attr.Type,
+			Description:        attr.Description,
+			Required:           attr.Required,
+			Optional:           attr.Optional,
+			Computed:           attr.Computed,
+			Sensitive:          attr.Sensitive,
+			DescriptionKind:    tfprotov6.StringKind(attr.DescriptionKind),
+			Deprecated:         attr.Deprecated,
+			WriteOnly:          attr.WriteOnly,
+			DeprecationMessage: attr.DeprecationMessage,
+		})
+	}
+	for _, block := range in.BlockTypes {
+		out.BlockTypes = append(out.BlockTypes, &tfprotov6.SchemaNestedBlock{
+			TypeName: block.TypeName,
+			Block:    protoV5ToV6SchemaBlock(block.Block),
+			Nesting:  tfprotov6.SchemaNestedBlockNestingMode(block.Nesting),
+			MinItems: block.MinItems,
+			MaxItems: block.MaxItems,
+		})
+	}
+	return out
+}
+
+func protoV5ToV6IdentitySchema(in *tfprotov5.ResourceIdentitySchema) *tfprotov6.ResourceIdentitySchema {
+	if in == nil {
+		return nil
+	}
+	out := &tfprotov6.ResourceIdentitySchema{
+		Version: in.Version,
+	}
+	for _, attr := range in.IdentityAttributes {
+		out.IdentityAttributes = append(out.IdentityAttributes, &tfprotov6.ResourceIdentitySchemaAttribute{
+			Name:              attr.Name,
+			Type:              attr.Type,
+			RequiredForImport: attr.RequiredForImport,
+			OptionalForImport: attr.OptionalForImport,
+			Description:       attr.Description,
+		})
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testListResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+					},
+				}
+			},
+		},
+	}
+}
+
+func TestListResourceRawV6Schemas(t *testing.T) {
+	resp := list.RawV6SchemaResponse{}
+	ListResourceRawV6Schemas(context.Background(), testListResource(), &resp)
+
+	assert.NotNil(t, resp.ProtoV6Schema)
+	attributes := map[string]*tfprotov6.SchemaAttribute{}
+	for _, attr := range resp.ProtoV6Schema.Block.Attributes {
+		attributes[attr.Name] = attr
+	}
+	assert.True(t, attributes["name"].Required)
+	assert.Equal(t, tftypes.String, attributes["name"].Type)
+	assert.True(t, attributes["id"].Computed)
+
+	assert.Len(t, resp.ProtoV6Schema.Block.BlockTypes, 1)
+	rule := resp.ProtoV6Schema.Block.BlockTypes[0]
+	assert.Equal(t, "rule", rule.TypeName)
+	assert.Equal(t, tfprotov6.SchemaNestedBlockNestingModeList, rule.Nesting)
+	assert.Equal(t, int64(1), rule.MaxItems)
+	assert.Equal(t, "port", rule.Block.Attributes[0].Name)
+
+	assert.NotNil(t, resp.ProtoV6IdentitySchema)
+	assert.Len(t, resp.ProtoV6IdentitySchema.IdentityAttributes, 1)
+	assert.Equal(t, "id", resp.ProtoV6IdentitySchema.IdentityAttributes[0].Name)
+	assert.True(t, resp.ProtoV6IdentitySchema.IdentityAttributes[0].RequiredForImport)
+}
+
+func TestListResourceRawV6SchemasWithoutIdentity(t *testing.T) {
+	r := testListResource()
+	r.Identity = nil
+
+	resp := list.RawV6SchemaResponse{}
+	ListResourceRawV6Schemas(context.Background(), r, &resp)
+
+	assert.NotNil(t, resp.ProtoV6Schema)
+	assert.Nil(t, resp.ProtoV6IdentitySchema)
+}
@@ -6,6 +6,9 @@ import (
 
 	v "github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
@@ -67,6 +70,50 @@ func (e *TerraformProblem) GetDiag() diag.Diagnostics {
 	return diag.Errorf("%s", e.GetConsoleMessage())
 }
 
+// GetDiagnostic returns a Diagnostic that keeps the severity of the problem,
+// with the summary of the problem as the diagnostic summary and the debug
+// message, which includes the "caused by" problems, as the detail. The
+// diagnostic is attached to attributePath unless it is nil.
+func (e *TerraformProblem) GetDiagnostic(attributePath cty.Path) diag.Diagnostic {
+	severity := diag.Error
+	if e.Severity == core.WarningSeverity {
+		severity = diag.Warning
+	}
+	return diag.Diagnostic{
+		Severity:      severity,
+		Summary:       e.Summary,
+		Detail:        e.GetDebugMessage(),
+		AttributePath: attributePath,
+	}
+}
+
+// GetStructuredDiag returns a new Diagnostics object holding the
+// Diagnostic returned by GetDiagnostic. It can be used instead of
+// GetDiag when the severity, the detail or the attribute path of
+// the problem should be kept.
+func (e *TerraformProblem) GetStructuredDiag(attributePath cty.Path) diag.Diagnostics {
+	return diag.Diagnostics{e.GetDiagnostic(attributePath)}
+}
+
+// GetFrameworkDiag is the equivalent of GetStructuredDiag for actions,
+// ephemeral resources and other plugin framework implementations. The
+// diagnostic is attached to attributePath unless it is empty.
+func (e *TerraformProblem) GetFrameworkDiag(attributePath path.Path) fwdiag.Diagnostics {
+	detail := e.GetDebugMessage()
+	var diagnostic fwdiag.Diagnostic
+	switch {
+	case e.Severity == core.WarningSeverity && attributePath.Equal(path.Empty()):
+		diagnostic = fwdiag.NewWarningDiagnostic(e.Summary, detail)
+	case e.Severity == core.WarningSeverity:
+		diagnostic = fwdiag.NewAttributeWarningDiagnostic(attributePath, e.Summary, detail)
+	case attributePath.Equal(path.Empty()):
+		diagnostic = fwdiag.NewErrorDiagnostic(e.Summary, detail)
+	default:
+		diagnostic = fwdiag.NewAttributeErrorDiagnostic(attributePath, e.Summary, detail)
+	}
+	return fwdiag.Diagnostics{diagnostic}
+}
+
 // TerraformErrorf creates and returns a new instance of `TerraformProblem`
 // with "error" level severity and a blank discriminator - the "caused by"
 // error is used to ensure uniqueness. This is a convenience function to
@@ -7,6 +7,10 @@ import (
 
 	v "github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -134,6 +138,67 @@ func TestTerraformProblemGetDiag(t *testing.T) {
 	assert.Equal(t, terraformProb.GetConsoleMessage(), diagnostic.Summary)
 }
 
+func TestTerraformProblemGetStructuredDiag(t *testing.T) {
+	terraformProb := getPopulatedTerraformProblem()
+	diagnostics := terraformProb.GetStructuredDiag(nil)
+
+	assert.True(t, diagnostics.HasError())
+	assert.Len(t, diagnostics, 1)
+
+	diagnostic := diagnostics[0]
+	assert.Nil(t, diagnostic.Validate())
+	assert.Equal(t, diag.Error, diagnostic.Severity)
+	assert.Equal(t, "Create failed.", diagnostic.Summary)
+	assert.Equal(t, terraformProb.GetDebugMessage(), diagnostic.Detail)
+	assert.Nil(t, diagnostic.AttributePath)
+}
+
+func TestTerraformProblemGetStructuredDiagWarning(t *testing.T) {
+	terraformProb := getPopulatedTerraformProblem()
+	terraformProb.Severity = core.WarningSeverity
+	attributePath := cty.GetAttrPath("name")
+	diagnostics := terraformProb.GetStructuredDiag(attributePath)
+
+	assert.False(t, diagnostics.HasError())
+	assert.Len(t, diagnostics, 1)
+	assert.Equal(t, diag.Warning, diagnostics[0].Severity)
+	assert.Equal(t, attributePath, diagnostics[0].AttributePath)
+}
+
+func TestTerraformProblemGetStructuredDiagCausedBy(t *testing.T) {
+	causedBy := core.SDKErrorf(nil, "Request failed.", "request-failed", core.NewProblemComponent("some-sdk", "1.0.0"))
+	terraformProb := TerraformErrorf(causedBy, "Create failed.", "ibm_some_resource", "create")
+	diagnostic := terraformProb.GetDiagnostic(nil)
+
+	assert.Equal(t, "Create failed.", diagnostic.Summary)
+	assert.Contains(t, diagnostic.Detail, "caused_by:")
+	assert.Contains(t, diagnostic.Detail, "Request failed.")
+}
+
+func TestTerraformProblemGetFrameworkDiag(t *testing.T) {
+	terraformProb := getPopulatedTerraformProblem()
+	diagnostics := terraformProb.GetFrameworkDiag(path.Empty())
+
+	assert.True(t, diagnostics.HasError())
+	assert.Len(t, diagnostics, 1)
+	assert.Equal(t, "Create failed.", diagnostics[0].Summary())
+	assert.Equal(t, terraformProb.GetDebugMessage(), diagnostics[0].Detail())
+	_, ok := diagnostics[0].(fwdiag.DiagnosticWithPath)
+	assert.False(t, ok)
+}
+
+func TestTerraformProblemGetFrameworkDiagWarning(t *testing.T) {
+	terraformProb := getPopulatedTerraformProblem()
+	terraformProb.Severity = core.WarningSeverity
+	diagnostics := terraformProb.GetFrameworkDiag(path.Root("name"))
+
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, 1, diagnostics.WarningsCount())
+	withPath, ok := diagnostics[0].(fwdiag.DiagnosticWithPath)
+	assert.True(t, ok)
+	assert.True(t, withPath.Path().Equal(path.Root("name")))
+}
+
 func TestTerraformErrorf(t *testing.T) {
 	causedBy := &core.SDKProblem{}
 	summary := "Update failed."
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// planWarnings returns the warnings of the planned state of a resource. The
+// CustomizeDiff of an SDKv2 resource can only fail the plan, so the warnings
+// are added to the plan by the server of GRPCProviderWithPlanWarnings.
+var planWarnings = map[string]func(planned cty.Value) diag.Diagnostics{
+	"ibm_pi_instance": power.ResourceIBMPIInstancePlanWarnings,
+}
+
+// GRPCProviderWithPlanWarnings returns the gRPC server of the provider, which
+// adds the planWarnings of a resource to the plan of its update.
+func GRPCProviderWithPlanWarnings(p *schema.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &planWarningsServer{
+			ProviderServer: p.GRPCProvider(),
+			provider:       p,
+		}
+	}
+}
+
+type planWarningsServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func (s *planWarningsServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil || resp.PlannedState == nil || req.PriorState == nil {
+		return resp, err
+	}
+	warnings, ok := planWarnings[req.TypeName]
+	resource, found := s.provider.ResourcesMap[req.TypeName]
+	if !ok || !found {
+		return resp, nil
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return resp, nil
+		}
+	}
+
+	ty := resource.CoreConfigSchema().ImpliedType()
+	prior, err := msgpack.Unmarshal(req.PriorState.MsgPack, ty)
+	if err != nil {
+		log.Printf("[WARN] Unable to decode the prior state of %s: %s", req.TypeName, err)
+		return resp, nil
+	}
+	planned, err := msgpack.Unmarshal(resp.PlannedState.MsgPack, ty)
+	if err != nil {
+		log.Printf("[WARN] Unable to decode the planned state of %s: %s", req.TypeName, err)
+		return resp, nil
+	}
+
+	// Only an update has warnings, the state is kept as is when nothing changes
+	if prior.IsNull() || planned.IsNull() || prior.RawEquals(planned) {
+		return resp, nil
+	}
+	for _, d := range warnings(planned) {
+		severity := tfprotov5.DiagnosticSeverityWarning
+		if d.Severity == diag.Error {
+			severity = tfprotov5.DiagnosticSeverityError
+		}
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: severity,
+			Summary:  d.Summary,
+			Detail:   d.Detail,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGRPCProviderWithPlanWarnings plans the update of an ibm_pi_instance,
+// whose reboot_reasons are added to the plan as a warning.
+func TestGRPCProviderWithPlanWarnings(t *testing.T) {
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"ibm_pi_instance": {
+				Schema: map[string]*schema.Schema{
+					"pi_proc_type": {
+						Optional: true,
+						Type:     schema.TypeString,
+					},
+					"reboot_reasons": {
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Type:     schema.TypeList,
+					},
+				},
+				CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+					if diff.HasChange("pi_proc_type") {
+						return diff.SetNew("reboot_reasons", []string{"changing pi_proc_type shuts down and restarts the instance"})
+					}
+					return nil
+				},
+			},
+		},
+	}
+	ty := p.ResourcesMap["ibm_pi_instance"].CoreConfigSchema().ImpliedType()
+	server := provider.GRPCProviderWithPlanWarnings(p)()
+
+	state := func(procType string, reasons ...string) *tfprotov5.DynamicValue {
+		reasonsVal := cty.ListValEmpty(cty.String)
+		if len(reasons) > 0 {
+			vals := []cty.Value{}
+			for _, reason := range reasons {
+				vals = append(vals, cty.StringVal(reason))
+			}
+			reasonsVal = cty.ListVal(vals)
+		}
+		b, err := msgpack.Marshal(cty.ObjectVal(map[string]cty.Value{
+			"id":             cty.StringVal("instance"),
+			"pi_proc_type":   cty.StringVal(procType),
+			"reboot_reasons": reasonsVal,
+		}), ty)
+		require.NoError(t, err)
+		return &tfprotov5.DynamicValue{MsgPack: b}
+	}
+	config := func(procType string) *tfprotov5.DynamicValue {
+		b, err := msgpack.Marshal(cty.ObjectVal(map[string]cty.Value{
+			"id":             cty.NullVal(cty.String),
+			"pi_proc_type":   cty.StringVal(procType),
+			"reboot_reasons": cty.NullVal(cty.List(cty.String)),
+		}), ty)
+		require.NoError(t, err)
+		return &tfprotov5.DynamicValue{MsgPack: b}
+	}
+	plan := func(priorProcType, procType string, priorReasons ...string) []*tfprotov5.Diagnostic {
+		resp, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "ibm_pi_instance",
+			PriorState:       state(priorProcType, priorReasons...),
+			ProposedNewState: state(procType, priorReasons...),
+			Config:           config(procType),
+		})
+		require.NoError(t, err)
+		return resp.Diagnostics
+	}
+
+	t.Run("Reboot", func(t *testing.T) {
+		diags := plan("shared", "dedicated")
+		require.Len(t, diags, 1)
+		assert.Equal(t, tfprotov5.DiagnosticSeverityWarning, diags[0].Severity)
+		assert.Equal(t, "The update shuts down or restarts the instance", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "changing pi_proc_type shuts down and restarts the instance")
+	})
+	t.Run("NoChanges", func(t *testing.T) {
+		diags := plan("dedicated", "dedicated", "changing pi_proc_type shuts down and restarts the instance")
+		assert.Empty(t, diags)
+	})
+}
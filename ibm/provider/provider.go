@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"strconv"
 	"sync"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -78,6 +80,17 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
+// ProviderWithHTTPTransport returns a *schema.Provider whose IBM Cloud clients
+// send their requests through the given transport. The unit tests use it to
+// record and replay API calls.
+func ProviderWithHTTPTransport(transport http.RoundTripper) *schema.Provider {
+	provider := Provider()
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigureWithHTTPTransport(d, transport)
+	}
+	return provider
+}
+
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
 	provider := schema.Provider{
@@ -168,6 +181,24 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "The retry count to set for API calls.",
 			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The maximum number of API calls per second sent to each IBM Cloud service endpoint.",
+			},
+			"max_in_flight_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of API calls in progress at the same time.",
+			},
+			"max_retry_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum delay (in seconds) between two attempts of a rate limited API call.",
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -317,6 +348,7 @@ func Provider() *schema.Provider {
 			"ibm_function_namespace":                        functions.DataSourceIBMFunctionNamespace(),
 			"ibm_cis":                                       cis.DataSourceIBMCISInstance(),
 			"ibm_cis_dns_records":                           cis.DataSourceIBMCISDNSRecords(),
+			"ibm_cis_dns_zone_export":                       cis.DataSourceIBMCISDNSZoneExport(),
 			"ibm_cis_certificates":                          cis.DataSourceIBMCISCertificates(),
 			"ibm_cis_global_load_balancers":                 cis.DataSourceIBMCISGlbs(),
 			"ibm_cis_origin_pools":                          cis.DataSourceIBMCISOriginPools(),
@@ -407,6 +439,7 @@ func Provider() *schema.Provider {
 			"ibm_cos_backup_vault":                          cos.DataSourceIBMCosBackupVault(),
 			"ibm_cos_backup_policy":                         cos.DataSourceIBMCosBackupPolicy(),
 			"ibm_cos_bucket_object":                         cos.DataSourceIBMCosBucketObject(),
+			"ibm_cos_bucket_objects":                        cos.DataSourceIBMCosBucketObjects(),
 			"ibm_dns_domain_registration":                   classicinfrastructure.DataSourceIBMDNSDomainRegistration(),
 			"ibm_dns_domain":                                classicinfrastructure.DataSourceIBMDNSDomain(),
 			"ibm_dns_secondary":                             classicinfrastructure.DataSourceIBMDNSSecondary(),
@@ -415,6 +448,7 @@ func Provider() *schema.Provider {
 			"ibm_event_streams_schema_global_rule":          eventstreams.DataSourceIBMEventStreamsSchemaGlobalCompatibilityRule(),
 			"ibm_event_streams_quota":                       eventstreams.DataSourceIBMEventStreamsQuota(),
 			"ibm_event_streams_mirroring_config":            eventstreams.DataSourceIBMEventStreamsMirroringConfig(),
+			"ibm_event_streams_consumer_groups":             eventstreams.DataSourceIBMEventStreamsConsumerGroups(),
 			"ibm_hpcs":                                      hpcs.DataSourceIBMHPCS(),
 			"ibm_hpcs_managed_key":                          hpcs.DataSourceIbmManagedKey(),
 			"ibm_hpcs_key_template":                         hpcs.DataSourceIbmKeyTemplate(),
@@ -887,6 +921,7 @@ func Provider() *schema.Provider {
 			"ibm_sm_custom_credentials_configuration":                            secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmCustomCredentialsConfiguration()),
 			"ibm_sm_configurations":                                              secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmConfigurations()),
 			"ibm_sm_secrets":                                                     secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecrets()),
+			"ibm_sm_secret_versions":                                             secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretVersions()),
 			"ibm_sm_arbitrary_secret_metadata":                                   secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmArbitrarySecretMetadata()),
 			"ibm_sm_imported_certificate_metadata":                               secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmImportedCertificateMetadata()),
 			"ibm_sm_public_certificate_metadata":                                 secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPublicCertificateMetadata()),
@@ -1323,6 +1358,7 @@ func Provider() *schema.Provider {
 			"ibm_cr_retention_policy":                       registry.ResourceIBMCrRetentionPolicy(),
 			"ibm_cos_bucket":                                cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":               cos.ResourceIBMCOSBucketReplicationConfiguration(),
+			"ibm_cos_bucket_directory":                      cos.ResourceIBMCOSBucketDirectory(),
 			"ibm_cos_bucket_object":                         cos.ResourceIBMCOSBucketObject(),
 			"ibm_cos_bucket_object_lock_configuration":      cos.ResourceIBMCOSBucketObjectlock(),
 			"ibm_cos_bucket_website_configuration":          cos.ResourceIBMCOSBucketWebsiteConfiguration(),
@@ -1338,6 +1374,8 @@ func Provider() *schema.Provider {
 			"ibm_event_streams_schema_global_rule":          eventstreams.ResourceIBMEventStreamsSchemaGlobalCompatibilityRule(),
 			"ibm_event_streams_quota":                       eventstreams.ResourceIBMEventStreamsQuota(),
 			"ibm_event_streams_mirroring_config":            eventstreams.ResourceIBMEventStreamsMirroringConfig(),
+			"ibm_event_streams_acl":                         eventstreams.ResourceIBMEventStreamsACL(),
+			"ibm_event_streams_consumer_group_offsets":      eventstreams.ResourceIBMEventStreamsConsumerGroupOffsets(),
 			"ibm_firewall":                                  classicinfrastructure.ResourceIBMFirewall(),
 			"ibm_firewall_policy":                           classicinfrastructure.ResourceIBMFirewallPolicy(),
 			"ibm_hpcs":                                      hpcs.ResourceIBMHPCS(),
@@ -2445,6 +2483,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_custom_certificates":         cis.DataSourceIBMCISCustomCertificatesValidator(),
 				"ibm_cis_custom_pages":                cis.DataSourceIBMCISCustomPagesValidator(),
 				"ibm_cis_dns_records":                 cis.DataSourceIBMCISDNSRecordsValidator(),
+				"ibm_cis_dns_zone_export":             cis.DataSourceIBMCISDNSZoneExportValidator(),
 				"ibm_cis_domain":                      cis.DataSourceIBMCISDomainValidator(),
 				"ibm_cis_certificates":                cis.DataSourceIBMCISCertificatesValidator(),
 				"ibm_cis_edge_functions_actions":      cis.DataSourceIBMCISEdgeFunctionsActionsValidator(),
@@ -2518,6 +2557,10 @@ func Validator() validate.ValidatorDict {
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	return providerConfigureWithHTTPTransport(d, nil)
+}
+
+func providerConfigureWithHTTPTransport(d *schema.ResourceData, transport http.RoundTripper) (interface{}, error) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
 	var iamToken, iamRefreshToken, iamTrustedProfileId, iamTrustedProfileName, account string
@@ -2758,6 +2801,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
+	requestsPerSecond := d.Get("requests_per_second").(float64)
+	maxInFlightRequests := d.Get("max_in_flight_requests").(int)
+	maxRetryDelay := d.Get("max_retry_delay").(int)
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -2810,6 +2856,39 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	// requests_per_second - default: unlimited
+	if requestsPerSecond == 0 {
+		if rps := os.Getenv("IBMCLOUD_REQUESTS_PER_SECOND"); rps != "" {
+			parsed, err := strconv.ParseFloat(rps, 64)
+			if err != nil || parsed < 0 {
+				return nil, fmt.Errorf("[ERROR] IBMCLOUD_REQUESTS_PER_SECOND must be a non-negative number, got %q", rps)
+			}
+			requestsPerSecond = parsed
+		}
+	}
+
+	// max_in_flight_requests - default: unlimited
+	if maxInFlightRequests == 0 {
+		if inFlight := os.Getenv("IBMCLOUD_MAX_IN_FLIGHT_REQUESTS"); inFlight != "" {
+			parsed, err := strconv.Atoi(inFlight)
+			if err != nil || parsed < 0 {
+				return nil, fmt.Errorf("[ERROR] IBMCLOUD_MAX_IN_FLIGHT_REQUESTS must be a non-negative integer, got %q", inFlight)
+			}
+			maxInFlightRequests = parsed
+		}
+	}
+
+	// max_retry_delay - default: 60
+	if maxRetryDelay == 0 {
+		if delay := os.Getenv("IBMCLOUD_MAX_RETRY_DELAY"); delay != "" {
+			parsed, err := strconv.Atoi(delay)
+			if err != nil || parsed < 0 {
+				return nil, fmt.Errorf("[ERROR] IBMCLOUD_MAX_RETRY_DELAY must be a non-negative integer, got %q", delay)
+			}
+			maxRetryDelay = parsed
+		}
+	}
+
 	wskEnvVal, err := schema.EnvDefaultFunc("FUNCTION_NAMESPACE", "")()
 	if err != nil {
 		return nil, err
@@ -2830,6 +2909,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		RetryCount:            retryCount,
 		SoftLayerEndpointURL:  softlayerEndpointUrl,
 		RetryDelay:            conns.RetryAPIDelay,
+		RequestsPerSecond:     requestsPerSecond,
+		MaxInFlightRequests:   maxInFlightRequests,
+		MaxRetryDelay:         time.Duration(maxRetryDelay) * time.Second,
 		FunctionNameSpace:     wskNameSpace,
 		RiaasEndPoint:         riaasEndPoint,
 		IAMToken:              iamToken,
@@ -2841,6 +2923,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		IAMTrustedProfileID:   iamTrustedProfileId,
 		IAMTrustedProfileName: iamTrustedProfileName,
 		Account:               account,
+		HTTPTransport:         transport,
 	}
 
 	return config.ClientSession()
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProviderReplay configures the provider with an API key and reads a
+// resource instance, answering the IAM and Resource Controller calls from a
+// cassette.
+func TestProviderReplay(t *testing.T) {
+	for _, env := range []string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_IAM_API_ENDPOINT", "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"} {
+		t.Setenv(env, "")
+	}
+	recorder, err := unittest.NewRecorderWithMode("test-fixtures/cassettes/provider_replay.json", unittest.RecorderModeReplay)
+	require.NoError(t, err)
+
+	p := provider.ProviderWithHTTPTransport(recorder)
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"ibmcloud_api_key": "replay-key",
+		"region":           "us-south",
+		"max_retries":      0,
+	}))
+	require.False(t, diags.HasError(), "%v", diags)
+
+	session := p.Meta().(conns.ClientSession)
+	userDetails, err := session.BluemixUserDetails()
+	require.NoError(t, err)
+	assert.Equal(t, "4ea1882a2d3401ed1e459979941966ea", userDetails.UserAccount)
+
+	rcClient, err := session.ResourceControllerV2API()
+	require.NoError(t, err)
+	instance, _, err := rcClient.GetResourceInstance(&resourcecontrollerv2.GetResourceInstanceOptions{
+		ID: core.StringPtr("31fa970d-51d0-4b05-893e-251cba75a7b3"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "replay-cos", *instance.Name)
+	assert.Equal(t, "active", *instance.State)
+}
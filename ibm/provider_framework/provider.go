@@ -5,22 +5,39 @@ package provider_framework
 
 import (
 	"context"
+	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/codeengine"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/database"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamidentity"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kms"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/schematics"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &frameworkProvider{}
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithListResources      = &frameworkProvider{}
 )
 
 // frameworkProvider is the provider implementation for the IBM Cloud Terraform Provider
@@ -30,37 +47,44 @@ type frameworkProvider struct {
 	// version is set to the provider version on release, "dev" when the
 	// provider is built and ran locally, and "test" when running acceptance testing.
 	version string
+
+	// httpTransport, when set, is used by the HTTP clients of the IBM Cloud
+	// services. The unit tests use it to record and replay API calls.
+	httpTransport http.RoundTripper
 }
 
 // frameworkProviderModel describes the provider data model.
 type frameworkProviderModel struct {
-	BluemixAPIKey          types.String `tfsdk:"bluemix_api_key"`
-	BluemixTimeout         types.Int64  `tfsdk:"bluemix_timeout"`
-	IBMCloudAPIKey         types.String `tfsdk:"ibmcloud_api_key"`
-	IBMCloudTimeout        types.Int64  `tfsdk:"ibmcloud_timeout"`
-	Region                 types.String `tfsdk:"region"`
-	Zone                   types.String `tfsdk:"zone"`
-	ResourceGroup          types.String `tfsdk:"resource_group"`
-	SoftlayerAPIKey        types.String `tfsdk:"softlayer_api_key"`
-	SoftlayerUsername      types.String `tfsdk:"softlayer_username"`
-	SoftlayerEndpointURL   types.String `tfsdk:"softlayer_endpoint_url"`
-	SoftlayerTimeout       types.Int64  `tfsdk:"softlayer_timeout"`
-	IAASClassicAPIKey      types.String `tfsdk:"iaas_classic_api_key"`
-	IAASClassicUsername    types.String `tfsdk:"iaas_classic_username"`
-	IAASClassicEndpointURL types.String `tfsdk:"iaas_classic_endpoint_url"`
-	IAASClassicTimeout     types.Int64  `tfsdk:"iaas_classic_timeout"`
-	MaxRetries             types.Int64  `tfsdk:"max_retries"`
-	FunctionNamespace      types.String `tfsdk:"function_namespace"`
-	RIAASEndpoint          types.String `tfsdk:"riaas_endpoint"`
-	Generation             types.Int64  `tfsdk:"generation"`
-	IAMProfileID           types.String `tfsdk:"iam_profile_id"`
-	IAMProfileName         types.String `tfsdk:"iam_profile_name"`
-	IAMToken               types.String `tfsdk:"iam_token"`
-	IAMRefreshToken        types.String `tfsdk:"iam_refresh_token"`
-	Visibility             types.String `tfsdk:"visibility"`
-	PrivateEndpointType    types.String `tfsdk:"private_endpoint_type"`
-	EndpointsFilePath      types.String `tfsdk:"endpoints_file_path"`
-	IBMCloudAccountID      types.String `tfsdk:"ibmcloud_account_id"`
+	BluemixAPIKey          types.String  `tfsdk:"bluemix_api_key"`
+	BluemixTimeout         types.Int64   `tfsdk:"bluemix_timeout"`
+	IBMCloudAPIKey         types.String  `tfsdk:"ibmcloud_api_key"`
+	IBMCloudTimeout        types.Int64   `tfsdk:"ibmcloud_timeout"`
+	Region                 types.String  `tfsdk:"region"`
+	Zone                   types.String  `tfsdk:"zone"`
+	ResourceGroup          types.String  `tfsdk:"resource_group"`
+	SoftlayerAPIKey        types.String  `tfsdk:"softlayer_api_key"`
+	SoftlayerUsername      types.String  `tfsdk:"softlayer_username"`
+	SoftlayerEndpointURL   types.String  `tfsdk:"softlayer_endpoint_url"`
+	SoftlayerTimeout       types.Int64   `tfsdk:"softlayer_timeout"`
+	IAASClassicAPIKey      types.String  `tfsdk:"iaas_classic_api_key"`
+	IAASClassicUsername    types.String  `tfsdk:"iaas_classic_username"`
+	IAASClassicEndpointURL types.String  `tfsdk:"iaas_classic_endpoint_url"`
+	IAASClassicTimeout     types.Int64   `tfsdk:"iaas_classic_timeout"`
+	MaxRetries             types.Int64   `tfsdk:"max_retries"`
+	RequestsPerSecond      types.Float64 `tfsdk:"requests_per_second"`
+	MaxInFlightRequests    types.Int64   `tfsdk:"max_in_flight_requests"`
+	MaxRetryDelay          types.Int64   `tfsdk:"max_retry_delay"`
+	FunctionNamespace      types.String  `tfsdk:"function_namespace"`
+	RIAASEndpoint          types.String  `tfsdk:"riaas_endpoint"`
+	Generation             types.Int64   `tfsdk:"generation"`
+	IAMProfileID           types.String  `tfsdk:"iam_profile_id"`
+	IAMProfileName         types.String  `tfsdk:"iam_profile_name"`
+	IAMToken               types.String  `tfsdk:"iam_token"`
+	IAMRefreshToken        types.String  `tfsdk:"iam_refresh_token"`
+	Visibility             types.String  `tfsdk:"visibility"`
+	PrivateEndpointType    types.String  `tfsdk:"private_endpoint_type"`
+	EndpointsFilePath      types.String  `tfsdk:"endpoints_file_path"`
+	IBMCloudAccountID      types.String  `tfsdk:"ibmcloud_account_id"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -72,6 +96,17 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// NewWithHTTPTransport is New with the HTTP transport used by the IBM Cloud
+// clients of the provider.
+func NewWithHTTPTransport(version string, transport http.RoundTripper) func() provider.Provider {
+	return func() provider.Provider {
+		return &frameworkProvider{
+			version:       version,
+			httpTransport: transport,
+		}
+	}
+}
+
 // Metadata returns the provider type name.
 func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "ibm"
@@ -154,6 +189,20 @@ func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:    true,
 				Description: "The retry count to set for API calls.",
 			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The maximum number of API calls per second sent to each IBM Cloud service endpoint.",
+			},
+			"max_in_flight_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of API calls in progress at the same time.",
+				Validators:  []validator.Int64{nonNegativeInt64Validator()},
+			},
+			"max_retry_delay": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum delay (in seconds) between two attempts of a rate limited API call.",
+				Validators:  []validator.Int64{nonNegativeInt64Validator()},
+			},
 			"function_namespace": schema.StringAttribute{
 				Optional:           true,
 				Description:        "The IBM Cloud Function namespace",
@@ -205,6 +254,16 @@ func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaReque
 	}
 }
 
+// nonNegativeInt64Validator rejects negative values, like the
+// validation.IntAtLeast(0) of the same attributes of the SDKv2 provider.
+func nonNegativeInt64Validator() validator.Int64 {
+	return validate.ValidateSchema{
+		ValidateFunctionIdentifier: validate.IntAtLeast,
+		Type:                       validate.TypeInt,
+		MinValue:                   "0",
+	}.Int64Validator()
+}
+
 // Configure prepares the provider for data sources and resources.
 // This method applies default values that were removed from the schema for mux compatibility.
 func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -273,6 +332,75 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
+	// requests_per_second - default: unlimited
+	if config.RequestsPerSecond.IsNull() {
+		if rps := os.Getenv("IBMCLOUD_REQUESTS_PER_SECOND"); rps != "" {
+			parsed, err := strconv.ParseFloat(rps, 64)
+			if err != nil || parsed < 0 {
+				resp.Diagnostics.AddError(
+					"Invalid IBMCLOUD_REQUESTS_PER_SECOND",
+					"IBMCLOUD_REQUESTS_PER_SECOND must be a non-negative number, got "+strconv.Quote(rps)+".",
+				)
+				return
+			}
+			config.RequestsPerSecond = types.Float64Value(parsed)
+		}
+	}
+	if config.RequestsPerSecond.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid requests_per_second",
+			"requests_per_second must not be negative.",
+		)
+		return
+	}
+
+	// max_in_flight_requests - default: unlimited
+	if config.MaxInFlightRequests.IsNull() {
+		if inFlight := os.Getenv("IBMCLOUD_MAX_IN_FLIGHT_REQUESTS"); inFlight != "" {
+			parsed, err := strconv.ParseInt(inFlight, 10, 64)
+			if err != nil || parsed < 0 {
+				resp.Diagnostics.AddError(
+					"Invalid IBMCLOUD_MAX_IN_FLIGHT_REQUESTS",
+					"IBMCLOUD_MAX_IN_FLIGHT_REQUESTS must be a non-negative integer, got "+strconv.Quote(inFlight)+".",
+				)
+				return
+			}
+			config.MaxInFlightRequests = types.Int64Value(parsed)
+		}
+	}
+	if config.MaxInFlightRequests.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_in_flight_requests"),
+			"Invalid max_in_flight_requests",
+			"max_in_flight_requests must not be negative.",
+		)
+		return
+	}
+
+	// max_retry_delay - default: 60
+	if config.MaxRetryDelay.IsNull() {
+		if delay := os.Getenv("IBMCLOUD_MAX_RETRY_DELAY"); delay != "" {
+			parsed, err := strconv.ParseInt(delay, 10, 64)
+			if err != nil || parsed < 0 {
+				resp.Diagnostics.AddError(
+					"Invalid IBMCLOUD_MAX_RETRY_DELAY",
+					"IBMCLOUD_MAX_RETRY_DELAY must be a non-negative integer, got "+strconv.Quote(delay)+".",
+				)
+				return
+			}
+			config.MaxRetryDelay = types.Int64Value(parsed)
+		}
+	}
+	if config.MaxRetryDelay.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retry_delay"),
+			"Invalid max_retry_delay",
+			"max_retry_delay must not be negative.",
+		)
+		return
+	}
+
 	// visibility default: "public"
 	if config.Visibility.IsNull() || config.Visibility.ValueString() == "" {
 		if visibility := os.Getenv("IC_VISIBILITY"); visibility != "" {
@@ -426,13 +554,17 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 
 	// Create conns.Config to initialize client session
 	connConfig := conns.Config{
-		BluemixAPIKey:    apiKey,
-		Region:           config.Region.ValueString(),
-		BluemixTimeout:   time.Duration(config.IBMCloudTimeout.ValueInt64()) * time.Second,
-		SoftLayerTimeout: time.Duration(config.IAASClassicTimeout.ValueInt64()) * time.Second,
-		RetryCount:       int(config.MaxRetries.ValueInt64()),
-		RetryDelay:       conns.RetryAPIDelay,
-		Visibility:       config.Visibility.ValueString(),
+		BluemixAPIKey:       apiKey,
+		Region:              config.Region.ValueString(),
+		BluemixTimeout:      time.Duration(config.IBMCloudTimeout.ValueInt64()) * time.Second,
+		SoftLayerTimeout:    time.Duration(config.IAASClassicTimeout.ValueInt64()) * time.Second,
+		RetryCount:          int(config.MaxRetries.ValueInt64()),
+		RetryDelay:          conns.RetryAPIDelay,
+		RequestsPerSecond:   config.RequestsPerSecond.ValueFloat64(),
+		MaxInFlightRequests: int(config.MaxInFlightRequests.ValueInt64()),
+		MaxRetryDelay:       time.Duration(config.MaxRetryDelay.ValueInt64()) * time.Second,
+		Visibility:          config.Visibility.ValueString(),
+		HTTPTransport:       p.httpTransport,
 	}
 
 	// Handle optional fields
@@ -491,10 +623,12 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Set the client session for resources, data sources, and actions
+	// Set the client session for resources, data sources, ephemeral resources, actions and list resources
 	resp.DataSourceData = session
 	resp.ResourceData = session
+	resp.EphemeralResourceData = session
 	resp.ActionData = session
+	resp.ListResourceData = session
 }
 
 // Resources defines the resources implemented in the provider.
@@ -504,14 +638,52 @@ func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Res
 }
 
 // DataSources defines the data sources implemented in the provider.
-// Initially empty - all data sources remain in SDKv2 provider.
+// Most data sources remain in the SDKv2 provider.
 func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		schematics.NewSchematicsRemoteStateDataSource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+// Ephemeral resources are only available in the framework provider.
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		database.NewDatabaseCredentialsEphemeralResource,
+		iamidentity.NewIAMAuthTokenEphemeralResource,
+		iamidentity.NewIAMServiceAPIKeyEphemeralResource,
+		kubernetes.NewContainerClusterConfigEphemeralResource,
+		secretsmanager.NewSmArbitrarySecretEphemeralResource,
+		secretsmanager.NewSmIAMCredentialsSecretEphemeralResource,
+		secretsmanager.NewSmKvSecretEphemeralResource,
+		secretsmanager.NewSmUsernamePasswordSecretEphemeralResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		vpc.NewISInstanceListResource,
+		vpc.NewISSecurityGroupListResource,
+		vpc.NewISSubnetListResource,
+		vpc.NewISVolumeListResource,
+		vpc.NewISVPCListResource,
+	}
 }
 
 // Actions defines the actions implemented in the provider.
 func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		codeengine.NewCodeEngineBuildRunAction,
+		database.NewDatabasePointInTimeRestoreAction,
+		kms.NewKMSKeyRewrapAction,
+		kms.NewKMSKeyRotateAction,
+		power.NewPIInstancePowerAction,
+		power.NewPIInstanceReplicateAction,
+		schematics.NewSchematicsWorkspaceApplyAction,
+		schematics.NewSchematicsWorkspaceDestroyAction,
+		schematics.NewSchematicsWorkspacePlanAction,
+		secretsmanager.NewSmSecretRotateAction,
+		vpc.NewISInstancePowerAction,
 	}
 }
@@ -4250,7 +4250,12 @@ func dataSourceIbmBackupRecoveriesRead(context context.Context, d *schema.Resour
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		backupRecoveryClient = getClientWithInstanceEndpoint(backupRecoveryClient, bmxsession, instanceId, region, endpointType, serviceName)
+		backupRecoveryClient, err = getClientWithInstanceEndpoint(backupRecoveryClient, bmxsession, instanceId, region, endpointType, serviceName)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("unable to get the instance endpoint: %s", err), "(Data) ibm_backup_recovery_recoveries", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 	}
 
 	getRecoveriesOptions := &backuprecoveryv1.GetRecoveriesOptions{}
@@ -233,7 +233,12 @@ func dataSourceIbmBackupRecoveryAgentUpgradeTasksRead(context context.Context, d
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		backupRecoveryClient = getClientWithInstanceEndpoint(backupRecoveryClient, bmxsession, instanceId, region, endpointType, serviceName)
+		backupRecoveryClient, err = getClientWithInstanceEndpoint(backupRecoveryClient, bmxsession, instanceId, region, endpointType, serviceName)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("unable to get the instance endpoint: %s", err), "(Data) ibm_backup_recovery_agent_upgrade_tasks", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 	}
 
 	getUpgradeTasksOptions := &backuprecoveryv1.GetUpgradeTasksOptions{}
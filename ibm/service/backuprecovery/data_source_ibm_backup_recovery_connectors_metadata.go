@@ -138,7 +138,12 @@ func dataSourceIbmBackupRecoveryConnectorsMetadataRead(context context.Context,
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		backupRecoveryClient = getClientWithInstanceEndpoint(backupRecoveryClient, bmxsession, instanceId, region, endpointType, serviceName)
+		backupRecoveryClient, err = getClientWithInstanceEndpoint(backupRecoveryClient, bmxsession, instanceId, region, endpointType, serviceName)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("unable to get the instance endpoint: %s", err), "(Data) ibm_backup_recovery_connectors_metadata", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 	}
 
 	getConnectorMetadataOptions := &backuprecoveryv1.GetConnectorMetadataOptions{}
@@ -114,7 +114,12 @@ func dataSourceIbmBackupRecoveryDataSourceConnectionsRead(context context.Contex
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		backupRecoveryClient = getClientWithInstanceEndpoint(backupRecoveryClient, bmxsession, instanceId, region, endpointType, serviceName)
+		backupRecoveryClient, err = getClientWithInstanceEndpoint(backupRecoveryClient, bmxsession, instanceId, region, endpointType, serviceName)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("unable to get the instance endpoint: %s", err), "(Data) ibm_backup_recovery_data_source_connections", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 	}
 
 	getDataSourceConnectionsOptions := &backuprecoveryv1.GetDataSourceConnectionsOptions{}
@@ -392,7 +392,12 @@ func dataSourceIbmBackupRecoveryManagerGetAlertsRead(context context.Context, d
 		return tfErr.GetDiag()
 	}
 	if instanceId != "" {
-		managementApiClient = getManagerClientWithInstanceEndpoint(managementApiClient, bmxsession, instanceId, region, endpointType, serviceName)
+		managementApiClient, err = getManagerClientWithInstanceEndpoint(managementApiClient, bmxsession, instanceId, region, endpointType, serviceName)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("unable to get the instance endpoint: %s", err), "(Data) ibm_backup_recovery_manager_get_alerts", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 	}
 
 	getAlertsOptions := &backuprecoveryv1.GetAlertsOptions{}
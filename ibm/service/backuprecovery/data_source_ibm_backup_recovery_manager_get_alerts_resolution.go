@@ -161,7 +161,12 @@ func dataSourceIbmBackupRecoveryManagerGetAlertsResolutionRead(context context.C
 		return tfErr.GetDiag()
 	}
 	if instanceId != "" {
-		managementApiClient = getManagerClientWithInstanceEndpoint(managementApiClient, bmxsession, instanceId, region, endpointType, serviceName)
+		managementApiClient, err = getManagerClientWithInstanceEndpoint(managementApiClient, bmxsession, instanceId, region, endpointType, serviceName)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("unable to get the instance endpoint: %s", err), "(Data) ibm_backup_recovery_manager_get_alerts_resolution", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 	}
 
 	getManagementAlertResolutionOptions := &backuprecoveryv1.GetManagementAlertResolutionOptions{}
@@ -1,7 +1,6 @@
 package backuprecovery
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -36,12 +35,12 @@ func getInstanceIdAndRegion(d *schema.ResourceData) (string, string, string) {
 }
 
 // Clone the base backup recovery client and set the API endpoint per the instance with optional service name
-func getClientWithInstanceEndpoint(originalClient *backuprecoveryv1.BackupRecoveryV1, bmxsession *session.Session, instanceId, region, endpointType, serviceName string) *backuprecoveryv1.BackupRecoveryV1 {
+func getClientWithInstanceEndpoint(originalClient *backuprecoveryv1.BackupRecoveryV1, bmxsession *session.Session, instanceId, region, endpointType, serviceName string) (*backuprecoveryv1.BackupRecoveryV1, error) {
 	// build the api endpoint
 
 	// default endpoint_type is set to public
 	if instanceId == "" && region == "" {
-		return originalClient
+		return originalClient, nil
 	}
 
 	domain := "cloud.ibm.com"
@@ -54,7 +53,11 @@ func getClientWithInstanceEndpoint(originalClient *backuprecoveryv1.BackupRecove
 
 	iamUrl := os.Getenv("IBMCLOUD_IAM_API_ENDPOINT")
 	if iamUrl == "" {
-		iamUrl = conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
+		var err error
+		iamUrl, err = conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if strings.Contains(iamUrl, "test") {
@@ -73,7 +76,7 @@ func getClientWithInstanceEndpoint(originalClient *backuprecoveryv1.BackupRecove
 		Service: originalClient.Service.Clone(),
 	}
 	newClient.Service.SetServiceURL(endpoint)
-	return newClient
+	return newClient, nil
 }
 
 // Add the fields needed for building the instance endpoint to the given schema
@@ -110,28 +113,11 @@ func AddInstanceFields(resource *schema.Resource) *schema.Resource {
 	return resource
 }
 
-func BackupRecoverManagerEnvFallBack(endpointsFile, endpointType, region, str string) string {
+func BackupRecoverManagerEnvFallBack(endpointsFile, endpointType, region, str string) (string, error) {
 	if v := os.Getenv(str); v != "" {
-		return v
-	}
-	return fileFallBack(endpointsFile, endpointType, region, "IBMCLOUD_BACKUP_RECOVERY_MANAGER_API_KEY")
-
-}
-
-func fileFallBack(f, visibility, region, key string) string {
-	fileMap := map[string]interface{}{}
-	err := json.Unmarshal([]byte(f), &fileMap)
-	if err != nil {
-		return ""
+		return v, nil
 	}
-	if val, ok := fileMap[key]; ok {
-		if v, ok := val.(map[string]interface{})[visibility]; ok {
-			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
-				return r.(string)
-			}
-		}
-	}
-	return ""
+	return conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_BACKUP_RECOVERY_MANAGER_API_KEY", region, "")
 }
 
 // Clone the base backup recovery client and set the API endpoint per the instance
@@ -140,7 +126,10 @@ func setManagerClientAuth(originalClient *backuprecoveryv1.BackupRecoveryManagem
 
 	endpointsFile := bmxsession.Config.EndpointsFile
 
-	apiKey := BackupRecoverManagerEnvFallBack(endpointsFile, endpointType, region, "IBMCLOUD_BACKUP_RECOVERY_MANAGER_API_KEY")
+	apiKey, err := BackupRecoverManagerEnvFallBack(endpointsFile, endpointType, region, "IBMCLOUD_BACKUP_RECOVERY_MANAGER_API_KEY")
+	if err != nil {
+		return nil, err
+	}
 
 	if apiKey == "" {
 		err := fmt.Errorf("IBMCLOUD_BACKUP_RECOVERY_MANAGER_API_KEY not set in env or endpoints file")
@@ -163,12 +152,12 @@ func setManagerClientAuth(originalClient *backuprecoveryv1.BackupRecoveryManagem
 }
 
 // Clone the base backup recovery client and set the API endpoint per the instance and an optional service name
-func getManagerClientWithInstanceEndpoint(originalClient *backuprecoveryv1.BackupRecoveryManagementSreApiV1, bmxsession *session.Session, instanceId, region, endpointType, serviceName string) *backuprecoveryv1.BackupRecoveryManagementSreApiV1 {
+func getManagerClientWithInstanceEndpoint(originalClient *backuprecoveryv1.BackupRecoveryManagementSreApiV1, bmxsession *session.Session, instanceId, region, endpointType, serviceName string) (*backuprecoveryv1.BackupRecoveryManagementSreApiV1, error) {
 	// build the api endpoint
 
 	// default endpoint_type is set to public
 	if instanceId == "" {
-		return originalClient
+		return originalClient, nil
 	}
 
 	domain := "cloud.ibm.com"
@@ -180,7 +169,11 @@ func getManagerClientWithInstanceEndpoint(originalClient *backuprecoveryv1.Backu
 
 	iamUrl := os.Getenv("IBMCLOUD_IAM_API_ENDPOINT")
 	if iamUrl == "" {
-		iamUrl = conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
+		var err error
+		iamUrl, err = conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
+		if err != nil {
+			return nil, err
+		}
 	}
 	if strings.Contains(iamUrl, "test") {
 		domain = "test.cloud.ibm.com"
@@ -198,5 +191,5 @@ func getManagerClientWithInstanceEndpoint(originalClient *backuprecoveryv1.Backu
 		Service: originalClient.Service.Clone(),
 	}
 	newClient.Service.SetServiceURL(endpoint)
-	return newClient
+	return newClient, nil
 }
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisDNSZoneExportZoneFile = "zone_file"
+	cisDNSZoneExportRecords  = "records"
+)
+
+func DataSourceIBMCISDNSZoneExport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMCISDNSZoneExportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "DNS Zone CRN",
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_cis_dns_zone_export",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Zone Id",
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisDNSZoneExportZoneFile: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Content of the zone file in BIND format",
+			},
+			cisDNSZoneExportRecords: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "DNS records parsed from the zone file",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDNSRecordName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS Record Name",
+						},
+						cisDNSRecordType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS Record Type",
+						},
+						cisDNSRecordTTL: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "DNS Record Time To Live",
+						},
+						cisDNSRecordContent: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS Record content",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMCISDNSZoneExportValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+
+	iBMCISDNSZoneExportValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_dns_zone_export",
+		Schema:       validateSchema}
+	return &iBMCISDNSZoneExportValidator
+}
+
+func dataSourceIBMCISDNSZoneExportRead(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+
+	zoneFile, err := cisDNSZoneExport(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	records, err := parseCISZoneFile(zoneFile, "")
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the exported zone file: %s", err)
+	}
+
+	recordList := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		recordList = append(recordList, map[string]interface{}{
+			cisDNSRecordName:    record.Name,
+			cisDNSRecordType:    record.Type,
+			cisDNSRecordTTL:     record.TTL,
+			cisDNSRecordContent: record.Content,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", zoneID, crn))
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisDNSZoneExportZoneFile, zoneFile)
+	d.Set(cisDNSZoneExportRecords, recordList)
+	return nil
+}
+
+// cisDNSZoneExport returns the DNS records of the zone as a BIND zone file.
+func cisDNSZoneExport(meta interface{}, crn, zoneID string) (string, error) {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordBulkClientSession()
+	if err != nil {
+		return "", err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	opt := sess.NewGetDnsRecordsBulkOptions()
+	result, response, err := sess.GetDnsRecordsBulk(opt)
+	if err != nil {
+		log.Printf("Error exporting dns records: %s", response)
+		return "", err
+	}
+	defer result.Close()
+
+	buf, err := io.ReadAll(result)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error reading the exported dns records: %s", err)
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSZoneExportDataSource_basic(t *testing.T) {
+	node := "data.ibm_cis_dns_zone_export.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSZoneExportDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "zone_file"),
+					resource.TestCheckTypeSetElemNestedAttrs(node, "records.*", map[string]string{
+						"name":    fmt.Sprintf("test.%s", acc.CisDomainStatic),
+						"type":    "A",
+						"content": "192.168.0.10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(node, "records.*", map[string]string{
+						"name": acc.CisDomainStatic,
+						"type": "SOA",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSZoneExportDataSourceConfig() string {
+	return testAccCheckIBMCisDNSRecordConfigCisDSBasic("test", acc.CisDomainStatic) +
+		`
+	data "ibm_cis_dns_zone_export" "test" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = ibm_cis_dns_record.test.domain_id
+	}`
+}
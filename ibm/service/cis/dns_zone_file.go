@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// cisZoneFileRecord is a resource record of a BIND zone file.
+type cisZoneFileRecord struct {
+	Name    string
+	TTL     int
+	Type    string
+	Content string
+}
+
+// cisZoneFileNameTypes are the record types whose last field is a domain name,
+// which is qualified with the origin when it is relative.
+var cisZoneFileNameTypes = map[string]bool{
+	"CNAME": true,
+	"DNAME": true,
+	"MX":    true,
+	"NS":    true,
+	"PTR":   true,
+	"SRV":   true,
+}
+
+// parseCISZoneFile parses the records of a BIND zone file. Relative names are
+// qualified with the origin, which is overridden by the $ORIGIN directives of
+// the file. Names are returned in lower case without the trailing dot.
+func parseCISZoneFile(content, origin string) ([]cisZoneFileRecord, error) {
+	origin = strings.TrimSuffix(strings.ToLower(origin), ".")
+	defaultTTL := 0
+	previousName := ""
+	records := []cisZoneFileRecord{}
+
+	lines, err := cisZoneFileLines(content)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		fields := line.fields
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN without a domain name", line.number)
+			}
+			origin = cisZoneFileName(fields[1], origin)
+			continue
+		case "$TTL":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: $TTL without a value", line.number)
+			}
+			ttl, err := cisZoneFileTTL(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: the %s directive is not supported", line.number, fields[0])
+		}
+
+		record := cisZoneFileRecord{TTL: defaultTTL}
+		if line.continued {
+			if previousName == "" {
+				return nil, fmt.Errorf("line %d: record without a name", line.number)
+			}
+			record.Name = previousName
+		} else {
+			record.Name = cisZoneFileName(fields[0], origin)
+			fields = fields[1:]
+		}
+		previousName = record.Name
+
+		// The TTL and the class are optional and may be in any order.
+		for len(fields) > 0 {
+			if ttl, err := cisZoneFileTTL(fields[0]); err == nil {
+				record.TTL = ttl
+			} else if !cisZoneFileClass(fields[0]) {
+				break
+			}
+			fields = fields[1:]
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: record without a type and data", line.number)
+		}
+
+		record.Type = strings.ToUpper(fields[0])
+		data := fields[1:]
+		if record.Type != "TXT" && record.Type != "SPF" {
+			for i := range data {
+				data[i] = strings.ToLower(data[i])
+			}
+		}
+		if cisZoneFileNameTypes[record.Type] {
+			data[len(data)-1] = cisZoneFileName(data[len(data)-1], origin)
+		}
+		record.Content = strings.Join(data, " ")
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+type cisZoneFileLine struct {
+	number    int
+	continued bool
+	fields    []string
+}
+
+// cisZoneFileLines splits the zone file into its entries, removing comments,
+// joining the lines enclosed in parentheses and unquoting the strings.
+func cisZoneFileLines(content string) ([]cisZoneFileLine, error) {
+	var (
+		lines   []cisZoneFileLine
+		current *cisZoneFileLine
+		depth   int
+	)
+	for i, text := range strings.Split(content, "\n") {
+		text = strings.TrimRight(text, "\r")
+		if current == nil {
+			current = &cisZoneFileLine{
+				number:    i + 1,
+				continued: text != "" && (text[0] == ' ' || text[0] == '\t'),
+			}
+		}
+
+		field, quoted, inField := strings.Builder{}, false, false
+		endField := func() {
+			if inField {
+				current.fields = append(current.fields, field.String())
+			}
+			field.Reset()
+			inField = false
+		}
+	scan:
+		for j := 0; j < len(text); j++ {
+			c := text[j]
+			switch {
+			case quoted && c == '\\' && j+1 < len(text):
+				j++
+				field.WriteByte(text[j])
+			case c == '"':
+				quoted = !quoted
+				inField = true
+			case quoted:
+				field.WriteByte(c)
+			case c == ';':
+				break scan
+			case c == '(':
+				endField()
+				depth++
+			case c == ')':
+				endField()
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unbalanced parentheses", i+1)
+				}
+				depth--
+			case c == ' ' || c == '\t':
+				endField()
+			default:
+				field.WriteByte(c)
+				inField = true
+			}
+		}
+		if quoted {
+			return nil, fmt.Errorf("line %d: unterminated string", i+1)
+		}
+		endField()
+
+		if depth == 0 {
+			if len(current.fields) > 0 {
+				lines = append(lines, *current)
+			}
+			current = nil
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+	return lines, nil
+}
+
+// cisZoneFileName returns the name qualified with the origin, in lower case
+// and without the trailing dot.
+func cisZoneFileName(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case origin == "":
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// cisZoneFileTTL parses a TTL in seconds, or with the BIND units such as 1h30m.
+func cisZoneFileTTL(value string) (int, error) {
+	if ttl, err := strconv.Atoi(value); err == nil && ttl >= 0 {
+		return ttl, nil
+	}
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	ttl, number := 0, ""
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		n, _ := strconv.Atoi(number)
+		ttl += n * unit
+		number = ""
+	}
+	if number != "" || value == "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	return ttl, nil
+}
+
+func cisZoneFileClass(value string) bool {
+	switch strings.ToUpper(value) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+// cisZoneFileOrigin returns the name of the zone, which is the owner of the
+// SOA record.
+func cisZoneFileOrigin(records []cisZoneFileRecord) string {
+	for _, record := range records {
+		if record.Type == "SOA" {
+			return record.Name
+		}
+	}
+	return ""
+}
+
+// cisZoneFileMissingRecords returns the records of the source that are not in
+// the zone, ignoring the SOA record and the TTLs, which CIS may change, as
+// "name type content" strings.
+func cisZoneFileMissingRecords(source, zone []cisZoneFileRecord) []string {
+	existing := map[string]bool{}
+	for _, record := range zone {
+		existing[cisZoneFileRecordKey(record)] = true
+	}
+	missing := []string{}
+	for _, record := range source {
+		key := cisZoneFileRecordKey(record)
+		if record.Type != "SOA" && !existing[key] {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func cisZoneFileRecordKey(record cisZoneFileRecord) string {
+	return fmt.Sprintf("%s %s %s", record.Name, record.Type, record.Content)
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cisTestExportedZoneFile = `;;
+;; Domain:     example.com.
+;; Exported:   2025-01-01 00:00:00
+;;
+example.com.	3600	IN	SOA	ns1.example.net. dns.example.net. (
+		2045040960 10000 2400 604800 3600 )
+
+;; A Records
+www.example.com.	1	IN	A	192.0.2.1
+api.example.com.	300	IN	A	192.0.2.2
+
+;; CNAME Records
+docs.example.com.	1	IN	CNAME	www.example.com.
+
+;; MX Records
+example.com.	1	IN	MX	10 mail.example.com.
+
+;; TXT Records
+example.com.	1	IN	TXT	"v=spf1 include:_spf.example.net ~all"
+`
+
+func TestParseCISZoneFile(t *testing.T) {
+	records, err := parseCISZoneFile(cisTestExportedZoneFile, "")
+	require.NoError(t, err)
+	assert.Equal(t, []cisZoneFileRecord{
+		{Name: "example.com", TTL: 3600, Type: "SOA", Content: "ns1.example.net. dns.example.net. 2045040960 10000 2400 604800 3600"},
+		{Name: "www.example.com", TTL: 1, Type: "A", Content: "192.0.2.1"},
+		{Name: "api.example.com", TTL: 300, Type: "A", Content: "192.0.2.2"},
+		{Name: "docs.example.com", TTL: 1, Type: "CNAME", Content: "www.example.com"},
+		{Name: "example.com", TTL: 1, Type: "MX", Content: "10 mail.example.com"},
+		{Name: "example.com", TTL: 1, Type: "TXT", Content: "v=spf1 include:_spf.example.net ~all"},
+	}, records)
+	assert.Equal(t, "example.com", cisZoneFileOrigin(records))
+}
+
+func TestParseCISZoneFileRelativeNames(t *testing.T) {
+	source := `$TTL 1h
+@	IN	MX	10 mail ; relative exchange
+www	A	192.0.2.1
+	IN 300 AAAA 2001:db8::1
+$ORIGIN sub.example.com.
+DOCS	CNAME	www.example.com.
+`
+	records, err := parseCISZoneFile(source, "example.com.")
+	require.NoError(t, err)
+	assert.Equal(t, []cisZoneFileRecord{
+		{Name: "example.com", TTL: 3600, Type: "MX", Content: "10 mail.example.com"},
+		{Name: "www.example.com", TTL: 3600, Type: "A", Content: "192.0.2.1"},
+		{Name: "www.example.com", TTL: 300, Type: "AAAA", Content: "2001:db8::1"},
+		{Name: "docs.sub.example.com", TTL: 3600, Type: "CNAME", Content: "www.example.com"},
+	}, records)
+}
+
+func TestParseCISZoneFileInvalid(t *testing.T) {
+	for _, content := range []string{
+		"example.com. IN SOA ns1.example.net. dns.example.net. ( 1 2 3",
+		"www.example.com. IN TXT \"unterminated",
+		"www.example.com. 300 IN",
+		"\tIN A 192.0.2.1",
+		"$INCLUDE other.zone",
+	} {
+		_, err := parseCISZoneFile(content, "example.com")
+		assert.Error(t, err, content)
+	}
+}
+
+func TestCISZoneFileTTL(t *testing.T) {
+	for value, expected := range map[string]int{"300": 300, "1h": 3600, "1h30m": 5400, "1W": 604800} {
+		ttl, err := cisZoneFileTTL(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, ttl, value)
+	}
+	for _, value := range []string{"", "h", "1x", "10m5", "A"} {
+		_, err := cisZoneFileTTL(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestCISZoneFileMissingRecords(t *testing.T) {
+	zone, err := parseCISZoneFile(cisTestExportedZoneFile, "")
+	require.NoError(t, err)
+	source, err := parseCISZoneFile(`
+@	300	IN	SOA	ns1 dns 1 2 3 4 5
+www	300	IN	A	192.0.2.1
+docs	300	IN	CNAME	www
+@	300	IN	TXT	"v=spf1 include:_spf.example.net ~all"
+old	300	IN	A	192.0.2.9
+`, cisZoneFileOrigin(zone))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old.example.com A 192.0.2.9"}, cisZoneFileMissingRecords(source, zone))
+	assert.Empty(t, cisZoneFileMissingRecords(zone, zone))
+}
@@ -4,6 +4,9 @@
 package cis
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
@@ -21,6 +24,8 @@ const (
 	cisDNSRecordsImportFile               = "file"
 	cisDNSRecordsImportTotalRecordsParsed = "total_records_parsed"
 	cisDNSRecordsImportRecordsAdded       = "records_added"
+	cisDNSRecordsImportFileHash           = "file_hash"
+	cisDNSRecordsImportMissingRecords     = "missing_records"
 )
 
 func ResourceIBMCISDNSRecordsImport() *schema.Resource {
@@ -55,13 +60,25 @@ func ResourceIBMCISDNSRecordsImport() *schema.Resource {
 				Description: "added records count",
 				Computed:    true,
 			},
+			cisDNSRecordsImportFileHash: {
+				Type:        schema.TypeString,
+				Description: "SHA-256 hash of the imported file",
+				Computed:    true,
+			},
+			cisDNSRecordsImportMissingRecords: {
+				Type:        schema.TypeList,
+				Description: "Records of the file that are missing from the zone",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
-		Create:   resourceCISDNSRecordsImportUpdate,
-		Read:     resourceCISDNSRecordsImportRead,
-		Update:   resourceCISDNSRecordsImportRead,
-		Delete:   resourceCISDNSRecordsImportDelete,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceCISDNSRecordsImportUpdate,
+		Read:          resourceCISDNSRecordsImportRead,
+		Update:        resourceCISDNSRecordsImportUpdate,
+		Delete:        resourceCISDNSRecordsImportDelete,
+		CustomizeDiff: resourceCISDNSRecordsImportCustomizeDiff,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 func ResourceIBMCISDnsRecordsImportValidator() *validate.ResourceValidator {
@@ -91,10 +108,15 @@ func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{})
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	file := d.Get(cisDNSRecordsImportFile).(string)
 
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
 	f, err := os.Open(file)
 	if err != nil {
 		return err
 	}
+	defer f.Close()
 	opt := cisClient.NewPostDnsRecordsBulkOptions()
 	opt.SetFile(f)
 	result, response, err := cisClient.PostDnsRecordsBulk(opt)
@@ -105,8 +127,9 @@ func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{})
 	id := fmt.Sprintf("%v:%v:%s:%s:%s", *result.Result.TotalRecordsParsed,
 		*result.Result.RecsAdded, file, zoneID, crn)
 	d.SetId(id)
+	d.Set(cisDNSRecordsImportFileHash, cisDNSRecordsImportHash(content))
 
-	return nil
+	return resourceCISDNSRecordsImportRead(d, meta)
 
 }
 
@@ -122,9 +145,73 @@ func resourceCISDNSRecordsImportRead(d *schema.ResourceData, meta interface{}) e
 	d.Set(cisDNSRecordsImportFile, file)
 	d.Set(cisDNSRecordsImportTotalRecordsParsed, parsed)
 	d.Set(cisDNSRecordsImportRecordsAdded, added)
+
+	// Compare the file with the records exported from the zone, to re-import
+	// the file if some of its records were changed or deleted.
+	content, err := os.ReadFile(file)
+	if err != nil {
+		log.Printf("[WARN] Unable to read %s, skipping the drift detection of the imported records: %s", file, err)
+		return nil
+	}
+	if d.Get(cisDNSRecordsImportFileHash).(string) == "" {
+		d.Set(cisDNSRecordsImportFileHash, cisDNSRecordsImportHash(content))
+	}
+	zoneFile, err := cisDNSZoneExport(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	zoneRecords, err := parseCISZoneFile(zoneFile, "")
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the exported zone file: %s", err)
+	}
+	sourceRecords, err := parseCISZoneFile(string(content), cisZoneFileOrigin(zoneRecords))
+	if err != nil {
+		log.Printf("[WARN] Unable to parse %s, skipping the drift detection of the imported records: %s", file, err)
+		return nil
+	}
+	d.Set(cisDNSRecordsImportMissingRecords, cisZoneFileMissingRecords(sourceRecords, zoneRecords))
+	return nil
+}
+
+// resourceCISDNSRecordsImportCustomizeDiff plans a new import of the file when
+// its content changed, or when some of its records are missing from the zone.
+func resourceCISDNSRecordsImportCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.HasChange(cisDNSRecordsImportFile) {
+		return nil
+	}
+	content, err := os.ReadFile(diff.Get(cisDNSRecordsImportFile).(string))
+	if err != nil {
+		return nil
+	}
+
+	hash := cisDNSRecordsImportHash(content)
+	changed := hash != diff.Get(cisDNSRecordsImportFileHash).(string)
+	missing := len(diff.Get(cisDNSRecordsImportMissingRecords).([]interface{})) > 0
+	if !changed && !missing {
+		return nil
+	}
+	if changed {
+		if err := diff.SetNew(cisDNSRecordsImportFileHash, hash); err != nil {
+			return err
+		}
+	} else {
+		if err := diff.SetNewComputed(cisDNSRecordsImportFileHash); err != nil {
+			return err
+		}
+	}
+	for _, key := range []string{cisDNSRecordsImportMissingRecords, cisDNSRecordsImportTotalRecordsParsed, cisDNSRecordsImportRecordsAdded} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func cisDNSRecordsImportHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
 func resourceCISDNSRecordsImportDelete(d *schema.ResourceData, meta interface{}) error {
 	// Nothing to delete on CIS DNS Record import resource
 	d.SetId("")
@@ -35,6 +35,37 @@ func TestAccIBMCisDNSRecordsImport_Basic(t *testing.T) {
 	})
 }
 
+// TestAccIBMCisDNSRecordsImport_Drift deletes the imported records and checks
+// that the file is imported again.
+func TestAccIBMCisDNSRecordsImport_Drift(t *testing.T) {
+	name := "ibm_cis_dns_records_import." + "test"
+	file := "../../test-fixtures/dns_records_import.txt"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisDNSRecordsImportConfigBasic1(file),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "file_hash"),
+					resource.TestCheckResourceAttr(name, "missing_records.#", "0"),
+					testAccCheckIBMCisDNSRecordsImportRemoveImportedRecords(name),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckCisDNSRecordsImportConfigBasic1(file),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "missing_records.#", "0"),
+					testAccCheckIBMCisDNSRecordsImportRemoveImportedRecords(name),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckCisDNSRecordsImportConfigBasic1(file string) string {
 	return testAccCheckIBMCisDNSRecordConfigCisDSBasic(
 		"test-dns-record", acc.CisDomainStatic) +
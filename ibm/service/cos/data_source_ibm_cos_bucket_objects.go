@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMCosBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCosBucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limits the listing to the keys beginning with the prefix",
+			},
+			"delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Groups the keys containing the delimiter after the prefix into common prefixes",
+			},
+			"start_after": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists the keys after this key",
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of keys and common prefixes to list. All the keys are listed by default",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys of the listed objects",
+			},
+			"common_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The prefixes of the keys grouped by the delimiter",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The listed objects",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object key",
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object entity tag",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "COS object size in bytes",
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object last modified date",
+						},
+						"storage_class": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object storage class",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCosBucketObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	schET := os.Getenv("IBMCLOUD_ENV_SCH_COS_ENDPOINT_OVERRIDE")
+	if endpointType != "" && endpointType == "private" && schET != "" {
+		endpointType = schET
+	}
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listInput := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	prefix := d.Get("prefix").(string)
+	if prefix != "" {
+		listInput.Prefix = aws.String(prefix)
+	}
+	delimiter := d.Get("delimiter").(string)
+	if delimiter != "" {
+		listInput.Delimiter = aws.String(delimiter)
+	}
+	if v, ok := d.GetOk("start_after"); ok {
+		listInput.StartAfter = aws.String(v.(string))
+	}
+	maxKeys := d.Get("max_keys").(int)
+	if maxKeys > 0 && maxKeys < 1000 {
+		listInput.MaxKeys = aws.Int64(int64(maxKeys))
+	}
+
+	keys := make([]string, 0)
+	commonPrefixes := make([]string, 0)
+	objects := make([]map[string]interface{}, 0)
+	listed := 0
+	err = s3Client.ListObjectsV2PagesWithContext(ctx, listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, commonPrefix := range page.CommonPrefixes {
+			if maxKeys > 0 && listed >= maxKeys {
+				return false
+			}
+			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
+			listed++
+		}
+		for _, object := range page.Contents {
+			if maxKeys > 0 && listed >= maxKeys {
+				return false
+			}
+			key := aws.StringValue(object.Key)
+			keys = append(keys, key)
+			objects = append(objects, flattenCOSBucketObjectsObject(object))
+			listed++
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed listing objects of COS bucket (%s): %w", bucketName, err))
+	}
+	log.Printf("[DEBUG] Listed %d keys and %d common prefixes of COS bucket (%s) with prefix %q", len(keys), len(commonPrefixes), bucketName, prefix)
+
+	d.SetId(fmt.Sprintf("%s:objects:%s:delimiter:%s:location:%s", bucketCRN, prefix, delimiter, bucketLocation))
+	d.Set("keys", keys)
+	d.Set("common_prefixes", commonPrefixes)
+	d.Set("objects", objects)
+	return nil
+}
+
+func flattenCOSBucketObjectsObject(object *s3.Object) map[string]interface{} {
+	lastModified := ""
+	if object.LastModified != nil {
+		lastModified = object.LastModified.Format(time.RFC1123)
+	}
+	storageClass := aws.StringValue(object.StorageClass)
+	if storageClass == "" {
+		storageClass = s3.StorageClassStandard
+	}
+	return map[string]interface{}{
+		"key":           aws.StringValue(object.Key),
+		"etag":          strings.Trim(aws.StringValue(object.ETag), `"`),
+		"size":          int(aws.Int64Value(object.Size)),
+		"last_modified": lastModified,
+		"storage_class": storageClass,
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsDataSourceConfig_basic(name, acc.CosCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "keys.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "objects.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "objects.0.key", "site/css/main.css"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "objects.0.size", "16"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.all", "objects.0.etag"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.all", "objects.0.last_modified"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "objects.0.storage_class", "STANDARD"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.delimited", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.delimited", "common_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.delimited", "common_prefixes.0", "site/css/"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.limited", "keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsDataSourceConfig_basic(name string, crn string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			for_each = {
+				"site/index.html"   = "<h1>Acceptance testing</h1>"
+				"site/error.html"   = "<h1>Not found</h1>"
+				"site/css/main.css" = "h1 { margin: 0 }"
+			}
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = each.key
+			content         = each.value
+		}
+		data "ibm_cos_bucket_objects" "all" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "site/"
+			depends_on      = [ibm_cos_bucket_object.testacc]
+		}
+		data "ibm_cos_bucket_objects" "delimited" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "site/"
+			delimiter       = "/"
+			depends_on      = [ibm_cos_bucket_object.testacc]
+		}
+		data "ibm_cos_bucket_objects" "limited" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "site/"
+			max_keys        = 1
+			depends_on      = [ibm_cos_bucket_object.testacc]
+		}`, name, crn)
+}
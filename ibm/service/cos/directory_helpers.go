@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// cosDirectoryFiles walks the source directory and returns the SHA-256
+// checksum of each regular file, keyed by its slash separated path relative
+// to the directory. Symbolic links to files are followed, hidden files are
+// included.
+func cosDirectoryFiles(source string) (map[string]string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", source, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("[ERROR] Source (%s) is not a directory", source)
+	}
+
+	files := make(map[string]string)
+	err = filepath.WalkDir(source, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		checksum, err := cosFileChecksum(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = checksum
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", source, err)
+	}
+	return files, nil
+}
+
+func cosFileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// cosDirectoryPrefix returns the prefix of the object keys of a directory,
+// which always ends with a slash unless the directory is synced to the root
+// of the bucket.
+func cosDirectoryPrefix(prefix string) string {
+	prefix = strings.TrimLeft(prefix, "/")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix
+}
+
+// cosDirectoryContentType returns the content type of a file from the
+// overrides keyed by file extension, the extension of the file, or the
+// first 512 bytes of its content, in this order.
+func cosDirectoryContentType(path string, overrides map[string]interface{}) (string, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	for k, v := range overrides {
+		if ext != "" && strings.ToLower("."+strings.TrimPrefix(k, ".")) == ext {
+			return v.(string), nil
+		}
+	}
+	if contentType := mime.TypeByExtension(ext); ext != "" && contentType != "" {
+		return contentType, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	return http.DetectContentType(buf[:n]), nil
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCOSDirectoryFiles(t *testing.T) {
+	source := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(source, "css", "empty"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "index.html"), []byte("<h1>index</h1>"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "css", "main.css"), []byte(""), 0600))
+
+	files, err := cosDirectoryFiles(source)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"index.html":   "860e54827aac082096bee04bf39bbb540958590c744d4759ea92020c0282097a",
+		"css/main.css": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}, files)
+
+	_, err = cosDirectoryFiles(filepath.Join(source, "index.html"))
+	assert.Error(t, err)
+	_, err = cosDirectoryFiles(filepath.Join(source, "missing"))
+	assert.Error(t, err)
+}
+
+func TestCOSDirectoryPrefix(t *testing.T) {
+	assert.Equal(t, "", cosDirectoryPrefix(""))
+	assert.Equal(t, "", cosDirectoryPrefix("/"))
+	assert.Equal(t, "site/", cosDirectoryPrefix("site"))
+	assert.Equal(t, "site/", cosDirectoryPrefix("/site/"))
+	assert.Equal(t, "site/v1/", cosDirectoryPrefix("site/v1"))
+}
+
+func TestCOSDirectoryContentType(t *testing.T) {
+	source := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(source, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	testcases := []struct {
+		path      string
+		overrides map[string]interface{}
+		expected  string
+	}{
+		{path: write("index.html", "<h1>index</h1>"), expected: "text/html; charset=utf-8"},
+		{path: write("main.CSS", "h1 {}"), expected: "text/css; charset=utf-8"},
+		{path: write("site.webmanifest", "{}"), overrides: map[string]interface{}{"webmanifest": "application/manifest+json"}, expected: "application/manifest+json"},
+		{path: write("page.html", "<h1>page</h1>"), overrides: map[string]interface{}{".HTML": "text/html"}, expected: "text/html"},
+		{path: write("LICENSE", "Mozilla Public License"), expected: "text/plain; charset=utf-8"},
+		{path: write("image", "\x89PNG\x0D\x0A\x1A\x0A"), expected: "image/png"},
+	}
+	for _, tc := range testcases {
+		contentType, err := cosDirectoryContentType(tc.path, tc.overrides)
+		require.NoError(t, err, tc.path)
+		assert.Equal(t, tc.expected, contentType, tc.path)
+	}
+}
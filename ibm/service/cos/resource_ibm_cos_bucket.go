@@ -65,12 +65,55 @@ func resourceinstanceidDiffSuppress(_, old, new string, _ *schema.ResourceData)
 }
 func ResourceIBMCOSBucket() *schema.Resource {
 	return &schema.Resource{
-		Read:          resourceIBMCOSBucketRead,
-		Create:        resourceIBMCOSBucketCreate,
-		Update:        resourceIBMCOSBucketUpdate,
-		Delete:        resourceIBMCOSBucketDelete,
-		Exists:        resourceIBMCOSBucketExists,
-		Importer:      &schema.ResourceImporter{},
+		Read:   resourceIBMCOSBucketRead,
+		Create: resourceIBMCOSBucketCreate,
+		Update: resourceIBMCOSBucketUpdate,
+		Delete: resourceIBMCOSBucketDelete,
+		Exists: resourceIBMCOSBucketExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMCOSBucketImport,
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"bucket_name": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The name of the bucket.",
+					},
+					"resource_instance_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The CRN of the COS instance the bucket belongs to.",
+					},
+					"cross_region_location": {
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+						Description:       "The cross region location of the bucket.",
+					},
+					"region_location": {
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+						Description:       "The region location of the bucket.",
+					},
+					"single_site_location": {
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+						Description:       "The single site location of the bucket.",
+					},
+					"satellite_location_id": {
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+						Description:       "The satellite location ID of the bucket.",
+					},
+					"endpoint_type": {
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+						Description:       "The COS endpoint type used to manage the bucket: public, private or direct.",
+					},
+				}
+			},
+		},
 		CustomizeDiff: resourceExpiryValidate,
 
 		Timeouts: &schema.ResourceTimeout{
@@ -777,7 +820,10 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	apiEndpoint = conns.FileFallBack(rsConClient.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bLocation, apiEndpoint)
+	apiEndpoint, err = conns.FileFallBack(rsConClient.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bLocation, apiEndpoint)
+	if err != nil {
+		return err
+	}
 	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
@@ -945,7 +991,10 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 
 	if endpointType != "public" {
 		// User is expected to define both private and direct url type under "private" in endpoints file since visibility type "direct" is not supported.
-		cosConfigURL := conns.FileFallBack(rsConClient.Config.EndpointsFile, "private", "IBMCLOUD_COS_CONFIG_ENDPOINT", bLocation, cosConfigUrls[endpointType])
+		cosConfigURL, err := conns.FileFallBack(rsConClient.Config.EndpointsFile, "private", "IBMCLOUD_COS_CONFIG_ENDPOINT", bLocation, cosConfigUrls[endpointType])
+		if err != nil {
+			return err
+		}
 		cosConfigURL = conns.EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosConfigURL)
 		if cosConfigURL != "" {
 			sess.SetServiceURL(cosConfigURL)
@@ -1120,6 +1169,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 		abortFlag = true
 	}
 
+	identityInstanceID := resourceIBMCOSBucketIdentityInstanceID(d, serviceID, apiType)
 	//split satellite resource instance id to get the 1st value
 	if apiType == "sl" {
 		satloc_guid := strings.Split(serviceID, ":")
@@ -1144,7 +1194,10 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	}
 
-	apiEndpoint = conns.FileFallBack(rsConClient.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bLocation, apiEndpoint)
+	apiEndpoint, err = conns.FileFallBack(rsConClient.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bLocation, apiEndpoint)
+	if err != nil {
+		return err
+	}
 	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
@@ -1239,7 +1292,10 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if endpointType != "public" {
 		// User is expected to define both private and direct url type under "private" in endpoints file since visibility type "direct" is not supported.
-		cosConfigURL := conns.FileFallBack(rsConClient.Config.EndpointsFile, "private", "IBMCLOUD_COS_CONFIG_ENDPOINT", bLocation, cosConfigUrls[endpointType])
+		cosConfigURL, err := conns.FileFallBack(rsConClient.Config.EndpointsFile, "private", "IBMCLOUD_COS_CONFIG_ENDPOINT", bLocation, cosConfigUrls[endpointType])
+		if err != nil {
+			return err
+		}
 		cosConfigURL = conns.EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosConfigURL)
 		if cosConfigURL != "" {
 			sess.SetServiceURL(cosConfigURL)
@@ -1384,7 +1440,20 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		d.Set("object_lock", false)
 	}
-	return nil
+
+	identityEndpointType := parseBucketId(d.Id(), "configuredEndpointType")
+	if identityEndpointType == "" {
+		identityEndpointType = d.Get("endpoint_type").(string)
+	}
+	identity := map[string]interface{}{
+		"bucket_name":          bucketName,
+		"resource_instance_id": identityInstanceID,
+		"endpoint_type":        identityEndpointType,
+	}
+	if arg, ok := cosBucketLocationArgs[apiType]; ok {
+		identity[arg] = bLocation
+	}
+	return flex.SetResourceIdentity(d, identity)
 }
 
 func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error {
@@ -1451,7 +1520,10 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	apiEndpoint = conns.FileFallBack(rsConClient.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bLocation, apiEndpoint)
+	apiEndpoint, err = conns.FileFallBack(rsConClient.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bLocation, apiEndpoint)
+	if err != nil {
+		return err
+	}
 	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 
 	if apiEndpoint == "" {
@@ -1576,7 +1648,10 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	apiEndpoint = conns.FileFallBack(rsConClient.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bLocation, apiEndpoint)
+	apiEndpoint, err := conns.FileFallBack(rsConClient.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bLocation, apiEndpoint)
+	if err != nil {
+		return err
+	}
 	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 
 	if apiEndpoint == "" {
@@ -1710,7 +1785,10 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 
 	}
 
-	apiEndpoint = conns.FileFallBack(rsConClient.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bLocation, apiEndpoint)
+	apiEndpoint, err = conns.FileFallBack(rsConClient.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bLocation, apiEndpoint)
+	if err != nil {
+		return false, err
+	}
 
 	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 
@@ -1778,6 +1856,73 @@ func SelectSatlocCosApi(apiType string, serviceID string, bLocation string) stri
 	return ""
 }
 
+// cosBucketLocationArgs maps the API type stored in a bucket ID to the argument
+// holding the bucket location.
+var cosBucketLocationArgs = map[string]string{
+	"crl": "cross_region_location",
+	"rl":  "region_location",
+	"ssl": "single_site_location",
+	"sl":  "satellite_location_id",
+}
+
+// resourceIBMCOSBucketIdentityInstanceID returns the CRN of the COS instance for
+// the identity of the bucket. The ID of a satellite bucket only holds the GUID of
+// the instance, so the CRN is taken from the configuration or the identity.
+func resourceIBMCOSBucketIdentityInstanceID(d *schema.ResourceData, serviceID, apiType string) string {
+	if apiType != "sl" {
+		return serviceID
+	}
+	if crn := d.Get("resource_instance_id").(string); strings.HasPrefix(crn, "crn:") {
+		return crn
+	}
+	if identity, err := d.Identity(); err == nil {
+		if crn, ok := identity.Get("resource_instance_id").(string); ok && strings.HasPrefix(crn, "crn:") {
+			return crn
+		}
+	}
+	return serviceID
+}
+
+// resourceIBMCOSBucketImport builds the bucket ID from the identity of a bucket
+// imported by identity, in the format generated by resourceIBMCOSBucketCreate.
+func resourceIBMCOSBucketImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != "" {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting identity: %s", err)
+	}
+	bucketName := identity.Get("bucket_name").(string)
+	serviceID := identity.Get("resource_instance_id").(string)
+	endpointType := identity.Get("endpoint_type").(string)
+	var apiType, bLocation string
+	for locationType, arg := range cosBucketLocationArgs {
+		if location := identity.Get(arg).(string); location != "" {
+			if bLocation != "" {
+				return nil, fmt.Errorf("Provide only one of `cross_region_location`, `region_location`, `single_site_location` or `satellite_location_id`")
+			}
+			apiType, bLocation = locationType, location
+		}
+	}
+	if bucketName == "" || serviceID == "" || bLocation == "" {
+		return nil, fmt.Errorf("Expected identity to contain bucket_name, resource_instance_id and one of `cross_region_location`, `region_location`, `single_site_location` or `satellite_location_id`")
+	}
+	if apiType == "sl" {
+		// Satellite bucket IDs only hold the GUID of the instance
+		if crnSegments := strings.Split(serviceID, ":"); len(crnSegments) > 7 {
+			serviceID = crnSegments[7]
+		}
+	}
+	if endpointType == "" {
+		endpointType = "public"
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s:meta:%s:%s:%s", strings.Replace(serviceID, "::", "", -1), "bucket", bucketName, apiType, bLocation, endpointType))
+	return []*schema.ResourceData{d}, nil
+}
+
 func parseBucketId(id string, info string) string {
 	crn := strings.Split(id, ":meta:")[0]
 	meta := strings.Split(id, ":meta:")[1]
@@ -1808,6 +1953,14 @@ func parseBucketId(id string, info string) string {
 		return ""
 
 	}
+	// configuredEndpointType is the endpoint type of the ID, without the Schematics override
+	if info == "configuredEndpointType" {
+		s := strings.Split(meta, ":")
+		if len(s) > 2 {
+			return s[2]
+		}
+		return ""
+	}
 	return ""
 }
 
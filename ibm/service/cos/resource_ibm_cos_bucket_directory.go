@@ -0,0 +1,425 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cosDeleteObjectsBatchSize is the maximum number of keys of a DeleteObjects request.
+const cosDeleteObjectsBatchSize = 1000
+
+func ResourceIBMCOSBucketDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketDirectoryCreate,
+		ReadContext:   resourceIBMCOSBucketDirectoryRead,
+		UpdateContext: resourceIBMCOSBucketDirectoryUpdate,
+		DeleteContext: resourceIBMCOSBucketDirectoryDelete,
+		CustomizeDiff: resourceIBMCOSBucketDirectoryDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The local directory to sync to the bucket",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The prefix of the object keys, the files are synced to the root of the bucket by default",
+			},
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Content types of the objects keyed by file extension, overriding the detected content types",
+			},
+			"delete_remote": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects under the prefix that have no file in the source directory",
+			},
+			"upload_parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  "The number of files uploaded in parallel",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The SHA-256 checksums of the synced files, keyed by their path relative to the source directory",
+			},
+		},
+	}
+}
+
+func getCOSBucketDirectoryClient(d *schema.ResourceData, m interface{}) (*s3.S3, string, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	schET := os.Getenv("IBMCLOUD_ENV_SCH_COS_ENDPOINT_OVERRIDE")
+	if endpointType != "" && endpointType == "private" && schET != "" {
+		endpointType = schET
+	}
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, "", err
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return nil, "", err
+	}
+	return s3Client, bucketName, nil
+}
+
+func resourceIBMCOSBucketDirectoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := getCOSBucketDirectoryClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucketCRN := d.Get("bucket_crn").(string)
+	prefix := cosDirectoryPrefix(d.Get("prefix").(string))
+	d.SetId(fmt.Sprintf("%s:directory:%s:location:%s", bucketCRN, prefix, d.Get("bucket_location").(string)))
+
+	if err := syncCOSBucketDirectory(ctx, d, s3Client, bucketName, map[string]string{}, true); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMCOSBucketDirectoryRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketDirectoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := getCOSBucketDirectoryClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prefix := cosDirectoryPrefix(d.Get("prefix").(string))
+	remote, err := listCOSBucketDirectoryKeys(ctx, s3Client, bucketName, prefix)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket && !d.IsNewResource() {
+			log.Printf("[WARN] COS bucket (%s) not found, removing directory (%s) from state", bucketName, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing objects of COS bucket (%s) with prefix %q: %s", bucketName, prefix, err))
+	}
+
+	// Objects deleted outside of Terraform are dropped from the synced
+	// files, so that they are uploaded again. Objects without a file are
+	// only tracked when they are to be deleted.
+	files := make(map[string]string)
+	for rel, checksum := range expandCOSBucketDirectoryFiles(d.Get("files")) {
+		if remote[rel] {
+			files[rel] = checksum
+		}
+	}
+	if d.Get("delete_remote").(bool) {
+		for rel := range remote {
+			if _, ok := files[rel]; !ok {
+				files[rel] = ""
+			}
+		}
+	}
+	d.Set("files", files)
+	return nil
+}
+
+func resourceIBMCOSBucketDirectoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := getCOSBucketDirectoryClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("source", "files", "content_types", "delete_remote") {
+		oldFiles, _ := d.GetChange("files")
+		// Changed content types only apply to uploaded objects, all the
+		// files are uploaded again to update them.
+		uploadAll := d.HasChange("content_types")
+		if err := syncCOSBucketDirectory(ctx, d, s3Client, bucketName, expandCOSBucketDirectoryFiles(oldFiles), uploadAll); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMCOSBucketDirectoryRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketDirectoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := getCOSBucketDirectoryClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prefix := cosDirectoryPrefix(d.Get("prefix").(string))
+	keys := make([]string, 0)
+	for rel := range expandCOSBucketDirectoryFiles(d.Get("files")) {
+		keys = append(keys, prefix+rel)
+	}
+	if _, err := deleteCOSBucketDirectoryObjects(ctx, s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketDirectoryDiff plans the sync of the directory when the
+// checksums of the files in the source directory differ from the synced ones.
+func resourceIBMCOSBucketDirectoryDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source") {
+		return d.SetNewComputed("files")
+	}
+
+	local, err := cosDirectoryFiles(d.Get("source").(string))
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(local, expandCOSBucketDirectoryFiles(d.Get("files"))) {
+		return d.SetNew("files", local)
+	}
+	return nil
+}
+
+func expandCOSBucketDirectoryFiles(v interface{}) map[string]string {
+	files := make(map[string]string)
+	if m, ok := v.(map[string]interface{}); ok {
+		for rel, checksum := range m {
+			files[rel] = checksum.(string)
+		}
+	}
+	return files
+}
+
+// syncCOSBucketDirectory uploads the files of the source directory whose
+// checksum differs from the synced one and deletes the objects of the files
+// removed from the directory. The synced files are set even if the sync
+// fails, so that the files that failed are synced by the next apply.
+func syncCOSBucketDirectory(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName string, synced map[string]string, uploadAll bool) error {
+	source := d.Get("source").(string)
+	prefix := cosDirectoryPrefix(d.Get("prefix").(string))
+
+	local, err := cosDirectoryFiles(source)
+	if err != nil {
+		return err
+	}
+
+	deleteRemote := d.Get("delete_remote").(bool)
+	deletes := make([]string, 0)
+	for rel, checksum := range synced {
+		if _, ok := local[rel]; ok {
+			continue
+		}
+		// Objects without a checksum were not uploaded from the directory,
+		// they are kept when delete_remote has been disabled.
+		if checksum == "" && !deleteRemote {
+			delete(synced, rel)
+			continue
+		}
+		deletes = append(deletes, rel)
+	}
+	if deleteRemote {
+		remote, err := listCOSBucketDirectoryKeys(ctx, s3Client, bucketName, prefix)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing objects of COS bucket (%s) with prefix %q: %s", bucketName, prefix, err)
+		}
+		for rel := range remote {
+			if _, ok := local[rel]; !ok {
+				if _, ok := synced[rel]; !ok {
+					deletes = append(deletes, rel)
+				}
+			}
+		}
+	}
+
+	uploads := make([]string, 0)
+	for rel, checksum := range local {
+		if uploadAll || synced[rel] != checksum {
+			uploads = append(uploads, rel)
+		}
+	}
+	sort.Strings(uploads)
+	log.Printf("[INFO] Syncing directory (%s) to COS bucket (%s) with prefix %q: %d file(s) to upload, %d object(s) to delete", source, bucketName, prefix, len(uploads), len(deletes))
+
+	var errs []string
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, d.Get("upload_parallelism").(int))
+	uploader := s3manager.NewUploaderWithClient(s3Client)
+	contentTypes := d.Get("content_types").(map[string]interface{})
+	for _, rel := range uploads {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(rel string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := uploadCOSBucketDirectoryFile(ctx, uploader, bucketName, prefix+rel, filepath.Join(source, filepath.FromSlash(rel)), local[rel], contentTypes)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err.Error())
+				return
+			}
+			synced[rel] = local[rel]
+		}(rel)
+	}
+	wg.Wait()
+
+	keys := make([]string, 0, len(deletes))
+	for _, rel := range deletes {
+		keys = append(keys, prefix+rel)
+	}
+	deleted, err := deleteCOSBucketDirectoryObjects(ctx, s3Client, bucketName, keys)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	for _, key := range deleted {
+		delete(synced, strings.TrimPrefix(key, prefix))
+	}
+
+	d.Set("files", synced)
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("[ERROR] Error syncing directory (%s) to COS bucket (%s):\n%s", source, bucketName, strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func uploadCOSBucketDirectoryFile(ctx context.Context, uploader *s3manager.Uploader, bucketName, objectKey, path, checksum string, contentTypes map[string]interface{}) error {
+	contentType, err := cosDirectoryContentType(path, contentTypes)
+	if err != nil {
+		return fmt.Errorf("failed detecting content type of file (%s): %s", path, err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed opening file (%s): %s", path, err)
+	}
+	defer file.Close()
+
+	_, err = uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(objectKey),
+		Body:        file,
+		ContentType: aws.String(contentType),
+		Metadata: map[string]*string{
+			cosObjectChecksumMetadata: aws.String(checksum),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed putting object (%s): %s", objectKey, err)
+	}
+	return nil
+}
+
+// listCOSBucketDirectoryKeys returns the keys of the objects under the
+// prefix, relative to the prefix.
+func listCOSBucketDirectoryKeys(ctx context.Context, s3Client *s3.S3, bucketName, prefix string) (map[string]bool, error) {
+	listInput := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if prefix != "" {
+		listInput.Prefix = aws.String(prefix)
+	}
+
+	keys := make(map[string]bool)
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			rel := strings.TrimPrefix(aws.StringValue(object.Key), prefix)
+			// Skip the folder placeholder objects created by the console.
+			if rel == "" || strings.HasSuffix(rel, "/") {
+				continue
+			}
+			keys[rel] = true
+		}
+		return !lastPage
+	})
+	return keys, err
+}
+
+// deleteCOSBucketDirectoryObjects deletes the objects in batches and returns
+// the keys of the deleted objects.
+func deleteCOSBucketDirectoryObjects(ctx context.Context, s3Client *s3.S3, bucketName string, keys []string) ([]string, error) {
+	sort.Strings(keys)
+	deleted := make([]string, 0, len(keys))
+	var errs []string
+	for start := 0; start < len(keys); start += cosDeleteObjectsBatchSize {
+		end := min(start+cosDeleteObjectsBatchSize, len(keys))
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		out, err := s3Client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("[ERROR] Error deleting objects from COS bucket (%s): %s", bucketName, err)
+		}
+
+		failed := make(map[string]bool)
+		for _, e := range out.Errors {
+			failed[aws.StringValue(e.Key)] = true
+			errs = append(errs, fmt.Sprintf("failed deleting object (%s): %s", aws.StringValue(e.Key), aws.StringValue(e.Message)))
+		}
+		for _, key := range keys[start:end] {
+			if !failed[key] {
+				deleted = append(deleted, key)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return deleted, fmt.Errorf("[ERROR] Error deleting objects from COS bucket (%s):\n%s", bucketName, strings.Join(errs, "\n"))
+	}
+	return deleted, nil
+}
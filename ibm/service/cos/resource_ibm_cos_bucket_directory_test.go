@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketDirectory_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	source := t.TempDir()
+	testAccIBMCOSBucketDirectoryWriteFile(t, source, "index.html", "<h1>Acceptance testing</h1>")
+	testAccIBMCOSBucketDirectoryWriteFile(t, source, "css/main.css", "h1 { margin: 0 }")
+	testAccIBMCOSBucketDirectoryWriteFile(t, source, "data/site.webmanifest", "{}")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketDirectoryConfig_basic(name, acc.CosCRN, source, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_directory.testacc", "files.%", "3"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_directory.testacc", "files.css/main.css"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_object.index", "content_type", "text/html; charset=utf-8"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_object.manifest", "content_type", "application/manifest+json"),
+				),
+			},
+			{
+				// Files are added and removed without any change of the configuration.
+				PreConfig: func() {
+					testAccIBMCOSBucketDirectoryWriteFile(t, source, "error.html", "<h1>Not found</h1>")
+					if err := os.Remove(filepath.Join(source, "css", "main.css")); err != nil {
+						t.Fatalf("Error removing file: %s", err)
+					}
+				},
+				Config: testAccIBMCOSBucketDirectoryConfig_basic(name, acc.CosCRN, source, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_directory.testacc", "files.%", "3"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_directory.testacc", "files.error.html"),
+					resource.TestCheckNoResourceAttr("ibm_cos_bucket_directory.testacc", "files.css/main.css"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.site", "keys.#", "4"),
+				),
+			},
+			{
+				// The object that was not uploaded from the directory is
+				// deleted, which the ibm_cos_bucket_object resource plans to
+				// create again.
+				Config: testAccIBMCOSBucketDirectoryConfig_basic(name, acc.CosCRN, source, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_directory.testacc", "files.%", "3"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.site", "keys.#", "3"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketDirectoryWriteFile(t *testing.T, source, rel, content string) {
+	path := filepath.Join(source, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatalf("Error creating directory: %s", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Error writing file: %s", err)
+	}
+}
+
+func testAccIBMCOSBucketDirectoryConfig_basic(name, crn, source string, deleteRemote bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "remote" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "site/remote.txt"
+			content         = "No local source"
+		}
+		resource "ibm_cos_bucket_directory" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			source          = "%[3]s"
+			prefix          = "site"
+			delete_remote   = %[4]t
+			content_types = {
+				".webmanifest" = "application/manifest+json"
+			}
+		}
+		data "ibm_cos_bucket_object" "index" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "site/index.html"
+			depends_on      = [ibm_cos_bucket_directory.testacc]
+		}
+		data "ibm_cos_bucket_object" "manifest" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "site/data/site.webmanifest"
+			depends_on      = [ibm_cos_bucket_directory.testacc]
+		}
+		data "ibm_cos_bucket_objects" "site" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "site/"
+			depends_on      = [ibm_cos_bucket_object.remote, ibm_cos_bucket_directory.testacc]
+		}`, name, crn, filepath.ToSlash(source), deleteRemote)
+}
@@ -6,7 +6,10 @@ package cos
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
@@ -24,11 +27,19 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	// cosObjectChecksumMetadata is the user metadata holding the SHA-256
+	// checksum of the content uploaded by the provider.
+	cosObjectChecksumMetadata = "Content-Sha256"
+	cosObjectPartSizeUnit     = 1024 * 1024
+)
+
 func ResourceIBMCOSBucketObject() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMCOSBucketObjectCreate,
@@ -36,6 +47,7 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 		UpdateContext: resourceIBMCOSBucketObjectUpdate,
 		DeleteContext: resourceIBMCOSBucketObjectDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMCOSBucketObjectContentDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -83,6 +95,11 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Computed:    true,
 				Description: "COS object content length",
 			},
+			"content_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hexdigest of the uploaded COS object content, used to detect changes of the content",
+			},
 			"content_type": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -96,10 +113,11 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Default:      "public",
 			},
 			"etag": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "COS object MD5 hexdigest",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressCOSObjectETagDiff,
+				Description:      "COS object MD5 hexdigest",
 			},
 			"key": {
 				Type:        schema.TypeString,
@@ -157,6 +175,37 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Optional:    true,
 				Description: "Redirect a request to another object or an URL",
 			},
+			"source_hash": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Triggers the upload of the COS object content when changed, for example with filemd5() of the content_file",
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(s3.StorageClass_Values(), false),
+				Description:  "COS object storage class",
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{s3.ServerSideEncryptionAes256}, false),
+				Description:  "Server-side encryption algorithm of the COS object: AES256",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize / cosObjectPartSizeUnit)),
+				Description:  "Size in MiB of the parts of a multipart upload. Defaults to 5 MiB",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of parts of a multipart upload uploaded in parallel. Defaults to 5",
+			},
 		},
 	}
 }
@@ -181,46 +230,8 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 
 	objectKey := d.Get("key").(string)
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-		}
-		body = bytes.NewReader(contentRaw)
-	} else if v, ok := d.GetOk("content_file"); ok {
-		path := v.(string)
-		file, err := os.Open(path)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-			}
-		}()
-	}
-
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
-	}
-	//if website redirect location if given for a an object
-	if v, ok := d.GetOk("website_redirect"); ok {
-		putInput.WebsiteRedirectLocation = aws.String(v.(string))
-	}
-
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := uploadCOSObject(ctx, d, s3Client, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
 	}
 	if v, ok := d.GetOk("object_lock_mode"); ok {
 		if d, ok := d.GetOk("object_lock_retain_until_date"); ok {
@@ -305,6 +316,17 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("content_length", out.ContentLength)
 	d.Set("content_type", out.ContentType)
 	d.Set("etag", strings.Trim(aws.StringValue(out.ETag), `"`))
+	// Objects that were not uploaded by the provider have no checksum, the
+	// one of the last upload is kept.
+	if checksum := cosObjectMetadata(out.Metadata, cosObjectChecksumMetadata); checksum != "" {
+		d.Set("content_sha256", checksum)
+	}
+	if out.StorageClass != nil {
+		d.Set("storage_class", out.StorageClass)
+	} else {
+		d.Set("storage_class", s3.StorageClassStandard)
+	}
+	d.Set("server_side_encryption", out.ServerSideEncryption)
 	if out.LastModified != nil {
 		d.Set("last_modified", out.LastModified.Format(time.RFC1123))
 	} else {
@@ -376,53 +398,10 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if d.HasChanges("content", "content_base64", "content_file", "etag") {
-
-		var body io.ReadSeeker
-
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-			}
-
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-				}
-			}()
-		}
-
-		objectKey := d.Get("key").(string)
-
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
+	if d.HasChanges("content", "content_base64", "content_file", "etag", "content_sha256", "source_hash", "storage_class", "server_side_encryption") {
+		if err := uploadCOSObject(ctx, d, s3Client, bucketName, objectKey); err != nil {
+			return diag.FromErr(err)
 		}
-		if d.HasChange("website_redirect") {
-			if v, ok := d.GetOk("website_redirect"); ok {
-				putInput.WebsiteRedirectLocation = aws.String(v.(string))
-			}
-		}
-
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
-		}
-
 	}
 	if d.HasChange("object_lock_legal_hold_status") {
 		putObjectLegalHoldInput := &s3.PutObjectLegalHoldInput{
@@ -462,6 +441,167 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
 }
 
+// uploadCOSObject uploads the content of the object with the upload manager,
+// which splits large contents in parts uploaded in parallel.
+func uploadCOSObject(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string) error {
+	body, checksums, err := cosObjectContent(d.GetOk)
+	if err != nil {
+		return err
+	}
+	defer closeCOSObjectContent(body)
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		if v, ok := d.GetOk("part_size"); ok {
+			u.PartSize = int64(v.(int)) * cosObjectPartSizeUnit
+		}
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+	})
+
+	uploadInput := &s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+		Body:   body,
+		Metadata: map[string]*string{
+			cosObjectChecksumMetadata: aws.String(checksums.sha256),
+		},
+	}
+	//if website redirect location if given for a an object
+	if v, ok := d.GetOk("website_redirect"); ok {
+		uploadInput.WebsiteRedirectLocation = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("storage_class"); ok {
+		uploadInput.StorageClass = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		uploadInput.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if _, err := uploader.UploadWithContext(ctx, uploadInput); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	d.Set("content_sha256", checksums.sha256)
+	return nil
+}
+
+type cosObjectChecksums struct {
+	sha256 string
+	md5    string
+}
+
+// cosObjectContent returns the content of the object configured in content,
+// content_base64 or content_file, and its checksums. A content file is read
+// once to compute the checksums and rewound, the caller closes it.
+func cosObjectContent(getOk func(string) (interface{}, bool)) (io.ReadSeeker, cosObjectChecksums, error) {
+	var body io.ReadSeeker
+
+	if v, ok := getOk("content"); ok {
+		content := v.(string)
+		body = bytes.NewReader([]byte(content))
+	} else if v, ok := getOk("content_base64"); ok {
+		content := v.(string)
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, cosObjectChecksums{}, fmt.Errorf("[ERROR] Error decoding content_base64: %s", err)
+		}
+		body = bytes.NewReader(contentRaw)
+	} else if v, ok := getOk("content_file"); ok {
+		path := v.(string)
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, cosObjectChecksums{}, fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+		}
+		body = file
+	} else {
+		body = bytes.NewReader(nil)
+	}
+
+	sha256Hash := sha256.New()
+	md5Hash := md5.New()
+	_, err := io.Copy(io.MultiWriter(sha256Hash, md5Hash), body)
+	if err == nil {
+		_, err = body.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		closeCOSObjectContent(body)
+		return nil, cosObjectChecksums{}, fmt.Errorf("[ERROR] Error reading COS object content: %s", err)
+	}
+
+	return body, cosObjectChecksums{
+		sha256: hex.EncodeToString(sha256Hash.Sum(nil)),
+		md5:    hex.EncodeToString(md5Hash.Sum(nil)),
+	}, nil
+}
+
+func closeCOSObjectContent(body io.ReadSeeker) {
+	if file, ok := body.(*os.File); ok {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", file.Name(), err)
+		}
+	}
+}
+
+// resourceIBMCOSBucketObjectContentDiff plans the upload of the object when
+// the checksum of the configured content differs from the uploaded one, so
+// that changes of a content file are detected without setting etag.
+func resourceIBMCOSBucketObjectContentDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"content", "content_base64", "content_file"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("content_sha256")
+		}
+	}
+
+	body, checksums, err := cosObjectContent(d.GetOk)
+	if err != nil {
+		// The content file may be created during the apply.
+		log.Printf("[WARN] Unable to compute the checksum of the COS object content: %s", err)
+		return d.SetNewComputed("content_sha256")
+	}
+	closeCOSObjectContent(body)
+
+	uploaded := d.Get("content_sha256").(string)
+	if uploaded == "" && d.Id() != "" {
+		// Objects uploaded by earlier versions of the provider have no
+		// checksum, the MD5 etag of their single part upload is compared.
+		if checksums.md5 == d.Get("etag").(string) {
+			return nil
+		}
+	}
+	if checksums.sha256 != uploaded {
+		return d.SetNew("content_sha256", checksums.sha256)
+	}
+	return nil
+}
+
+// suppressCOSObjectETagDiff suppresses the diff of an etag set to the MD5
+// hexdigest of the content, such as filemd5(...), when the uploaded content is
+// unchanged. The etag of an object uploaded in parts is not the MD5 hexdigest
+// of its content, so the content is compared with content_sha256 instead.
+func suppressCOSObjectETagDiff(k, old, new string, d *schema.ResourceData) bool {
+	uploaded := d.Get("content_sha256").(string)
+	if old == "" || new == "" || uploaded == "" {
+		return false
+	}
+	body, checksums, err := cosObjectContent(d.GetOk)
+	if err != nil {
+		return false
+	}
+	closeCOSObjectContent(body)
+	return new == checksums.md5 && checksums.sha256 == uploaded
+}
+
+// cosObjectMetadata returns the value of a user metadata of an object. The
+// keys of the metadata returned by COS are canonicalized.
+func cosObjectMetadata(metadata map[string]*string, key string) string {
+	for k, v := range metadata {
+		if strings.EqualFold(k, key) {
+			return aws.StringValue(v)
+		}
+	}
+	return ""
+}
+
 func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	bucketCRN := d.Get("bucket_crn").(string)
 	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
@@ -526,7 +666,10 @@ func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointTy
 		visibility = "private"
 	}
 	apiEndpoint := getCosEndpoint(bucketLocation, endpointType)
-	apiEndpoint = conns.FileFallBack(bxSession.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bucketLocation, apiEndpoint)
+	apiEndpoint, err := conns.FileFallBack(bxSession.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bucketLocation, apiEndpoint)
+	if err != nil {
+		return nil, err
+	}
 	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 	if apiEndpoint == "" {
 		return nil, fmt.Errorf("the endpoint doesn't exists for given location %s and endpoint type %s", bucketLocation, endpointType)
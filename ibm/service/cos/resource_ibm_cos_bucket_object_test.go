@@ -4,9 +4,14 @@
 package cos_test
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 	"time"
@@ -70,6 +75,59 @@ func TestAccIBMCOSBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCOSBucketObject_MultipartUpload(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	objectFile := filepath.Join(t.TempDir(), "object.bin")
+	// Larger than two parts of 5 MiB, so that the content is uploaded in parts.
+	firstChecksum := testAccIBMCOSBucketObjectWriteFile(t, objectFile, "a", 12*1024*1024)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", fmt.Sprint(12*1024*1024)),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_sha256", firstChecksum),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "server_side_encryption", "AES256"),
+				),
+			},
+			{
+				// The content file changes without any change of the configuration.
+				PreConfig: func() {
+					testAccIBMCOSBucketObjectWriteFile(t, objectFile, "b", 12*1024*1024)
+				},
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_sha256", testAccIBMCOSBucketObjectChecksum("b", 12*1024*1024)),
+				),
+			},
+			{
+				// The multipart etag of the object is not the MD5 hexdigest of
+				// the content, which must not cause a diff.
+				Config: testAccIBMCOSBucketObjectConfig_multipartETag(name, instanceCRN, objectFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "etag", regexp.MustCompile(`-\d+$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectChecksum(fill string, size int) string {
+	checksum := sha256.Sum256(bytes.Repeat([]byte(fill), size))
+	return hex.EncodeToString(checksum[:])
+}
+
+func testAccIBMCOSBucketObjectWriteFile(t *testing.T, path, fill string, size int) string {
+	if err := os.WriteFile(path, bytes.Repeat([]byte(fill), size), 0600); err != nil {
+		t.Fatalf("Error writing COS object file: %s", err)
+	}
+	return testAccIBMCOSBucketObjectChecksum(fill, size)
+}
+
 func TestAccIBMCOSBucketObject_VersioningEnabled(t *testing.T) {
 	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
 	key := "plaintext.txt"
@@ -389,6 +447,45 @@ func testAccIBMCOSBucketObjectConfig_file(name string, instanceCRN string, objec
 		}`, name, instanceCRN, objectFile)
 }
 
+func testAccIBMCOSBucketObjectConfig_multipart(name string, instanceCRN string, objectFile string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn             = ibm_cos_bucket.testacc.crn
+			bucket_location        = ibm_cos_bucket.testacc.region_location
+			key                    = "%[1]s.bin"
+			content_file           = "%[3]s"
+			part_size              = 5
+			upload_concurrency     = 2
+			server_side_encryption = "AES256"
+		}`, name, instanceCRN, objectFile)
+}
+
+func testAccIBMCOSBucketObjectConfig_multipartETag(name string, instanceCRN string, objectFile string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn             = ibm_cos_bucket.testacc.crn
+			bucket_location        = ibm_cos_bucket.testacc.region_location
+			key                    = "%[1]s.bin"
+			content_file           = "%[3]s"
+			etag                   = filemd5("%[3]s")
+			part_size              = 5
+			upload_concurrency     = 2
+			server_side_encryption = "AES256"
+		}`, name, instanceCRN, objectFile)
+}
+
 func testAccIBMCOSBucketBucketObject_Versioning_Enabled(name string, key string, instanceCRN string, objectBody1 string, objectBody2 string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cos_bucket" "testacc" {
@@ -0,0 +1,462 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = &databasePointInTimeRestoreAction{}
+	_ action.ActionWithConfigure      = &databasePointInTimeRestoreAction{}
+	_ action.ActionWithValidateConfig = &databasePointInTimeRestoreAction{}
+)
+
+// databaseRestorePollInterval is how often the restored deployment and its
+// tasks are polled.
+const databaseRestorePollInterval = 10 * time.Second
+
+func NewDatabasePointInTimeRestoreAction() action.Action {
+	return &databasePointInTimeRestoreAction{}
+}
+
+type databasePointInTimeRestoreAction struct {
+	session conns.ClientSession
+}
+
+type databasePointInTimeRestoreModel struct {
+	SourceDeploymentID      types.String `tfsdk:"source_deployment_id"`
+	BackupID                types.String `tfsdk:"backup_id"`
+	PointInTimeRecoveryTime types.String `tfsdk:"point_in_time_recovery_time"`
+	Name                    types.String `tfsdk:"name"`
+	Location                types.String `tfsdk:"location"`
+	ResourceGroupID         types.String `tfsdk:"resource_group_id"`
+	ServiceEndpoints        types.String `tfsdk:"service_endpoints"`
+	OfflineRestore          types.Bool   `tfsdk:"offline_restore"`
+	AsyncRestore            types.Bool   `tfsdk:"async_restore"`
+	WaitTimeout             types.Int64  `tfsdk:"wait_timeout"`
+}
+
+func (a *databasePointInTimeRestoreAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_database_point_in_time_restore"
+}
+
+func (a *databasePointInTimeRestoreAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores a Cloud Databases backup, or the state of a deployment at a point in time, into a new deployment and waits until the restore completes. Cloud Databases always restores into a new deployment, which is not managed by Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"source_deployment_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The CRN of the deployment to restore. Required with point_in_time_recovery_time, and defaults to the deployment of the backup with backup_id.",
+			},
+			"backup_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The CRN of the backup to restore. Conflicts with point_in_time_recovery_time.",
+			},
+			"point_in_time_recovery_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "The time to restore the source deployment at, in RFC 3339 format, such as 2025-01-01T00:00:00Z. An empty string restores the most recent state. Conflicts with backup_id.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the new deployment.",
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Description: "The location of the new deployment. Defaults to the location of the source deployment.",
+			},
+			"resource_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the resource group of the new deployment. Defaults to the resource group of the source deployment.",
+			},
+			"service_endpoints": schema.StringAttribute{
+				Optional:    true,
+				Description: "The service endpoints of the new deployment. Allowable values are: public, private, public-and-private.",
+			},
+			"offline_restore": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Restore a MongoDB Enterprise Edition backup in offline mode.",
+			},
+			"async_restore": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Restore a PostgreSQL backup with Fast PG Restore.",
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds to wait for the restore to complete. If not specified, defaults to 3600 seconds (1 hour).",
+			},
+		},
+	}
+}
+
+func (a *databasePointInTimeRestoreAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config databasePointInTimeRestoreModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.BackupID.IsNull() == config.PointInTimeRecoveryTime.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("backup_id"),
+			"Invalid Restore Source",
+			"Exactly one of backup_id and point_in_time_recovery_time must be set.",
+		)
+		return
+	}
+
+	if !config.PointInTimeRecoveryTime.IsNull() {
+		if config.SourceDeploymentID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_deployment_id"),
+				"Missing Source Deployment",
+				"source_deployment_id must be set to restore a point in time.",
+			)
+		}
+		if pitrTime := strings.TrimSpace(config.PointInTimeRecoveryTime.ValueString()); pitrTime != "" && !config.PointInTimeRecoveryTime.IsUnknown() {
+			if _, err := time.Parse(time.RFC3339, pitrTime); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("point_in_time_recovery_time"),
+					"Invalid Point In Time",
+					fmt.Sprintf("point_in_time_recovery_time must be in RFC 3339 format, such as 2025-01-01T00:00:00Z: %s", err),
+				)
+			}
+		}
+	}
+
+	if !config.ServiceEndpoints.IsNull() && !config.ServiceEndpoints.IsUnknown() {
+		switch serviceEndpoints := config.ServiceEndpoints.ValueString(); serviceEndpoints {
+		case "public", "private", "public-and-private":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("service_endpoints"),
+				"Invalid Service Endpoints",
+				fmt.Sprintf("service_endpoints must be one of public, private, public-and-private, got: %s", serviceEndpoints),
+			)
+		}
+	}
+}
+
+func (a *databasePointInTimeRestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.session = session
+}
+
+func (a *databasePointInTimeRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config databasePointInTimeRestoreModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.session == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Provider Session",
+			"The ibm_database_point_in_time_restore action was invoked before the provider was configured.",
+		)
+		return
+	}
+
+	waitTimeout := 3600 * time.Second
+	if !config.WaitTimeout.IsNull() {
+		waitTimeout = time.Duration(config.WaitTimeout.ValueInt64()) * time.Second
+	}
+
+	cloudDatabasesClient, err := a.session.CloudDatabasesV5()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Databases Client", err.Error())
+		return
+	}
+	rsConClient, err := a.session.ResourceControllerV2API()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Resource Controller Client", err.Error())
+		return
+	}
+
+	params := Params{
+		ServiceEndpoints: config.ServiceEndpoints.ValueString(),
+		OfflineRestore:   config.OfflineRestore.ValueBool(),
+		AsyncRestore:     config.AsyncRestore.ValueBool(),
+	}
+	sourceID := config.SourceDeploymentID.ValueString()
+
+	if !config.BackupID.IsNull() {
+		backupID := config.BackupID.ValueString()
+		backupInfo, response, err := cloudDatabasesClient.GetBackupInfoWithContext(ctx, &clouddatabasesv5.GetBackupInfoOptions{
+			BackupID: &backupID,
+		})
+		if err != nil || backupInfo.Backup == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("backup_id"),
+				"Unable to Read Backup",
+				fmt.Sprintf("Error getting the backup (%s): %s\n%s", backupID, err, response),
+			)
+			return
+		}
+		backup := backupInfo.Backup
+		if backup.IsRestorable != nil && !*backup.IsRestorable {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("backup_id"),
+				"Backup Not Restorable",
+				fmt.Sprintf("The backup (%s) cannot be restored, its status is %s.", backupID, core.StringNilMapper(backup.Status)),
+			)
+			return
+		}
+		if sourceID == "" {
+			sourceID = core.StringNilMapper(backup.DeploymentID)
+		}
+		params.BackupID = backupID
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Restoring %s backup %s taken at %s", core.StringNilMapper(backup.Type), backupID, backup.CreatedAt),
+		})
+	} else {
+		pitrData, response, err := cloudDatabasesClient.GetPitrDataWithContext(ctx, &clouddatabasesv5.GetPitrDataOptions{
+			ID: &sourceID,
+		})
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_deployment_id"),
+				"Unable to Read Point In Time Recovery Data",
+				fmt.Sprintf("Error getting the point in time recovery data of the deployment (%s): %s\n%s", sourceID, err, response),
+			)
+			return
+		}
+		pitrTime := strings.TrimSpace(config.PointInTimeRecoveryTime.ValueString())
+		if pitrData.PointInTimeRecoveryData != nil && pitrData.PointInTimeRecoveryData.EarliestPointInTimeRecoveryTime != nil && pitrTime != "" {
+			earliest := *pitrData.PointInTimeRecoveryData.EarliestPointInTimeRecoveryTime
+			if databaseRestoreTimeBefore(pitrTime, earliest) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("point_in_time_recovery_time"),
+					"Point In Time Not Available",
+					fmt.Sprintf("The deployment (%s) can only be restored from %s on.", sourceID, earliest),
+				)
+				return
+			}
+		}
+		params.PITRDeploymentID = sourceID
+		params.PITRTimeStamp = &pitrTime
+		if pitrTime == "" {
+			pitrTime = "the most recent state"
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Restoring deployment %s at %s", sourceID, pitrTime),
+		})
+	}
+
+	source, response, err := rsConClient.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{
+		ID: &sourceID,
+	})
+	if err != nil || source == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Source Deployment",
+			fmt.Sprintf("Error getting the source deployment (%s): %s\n%s", sourceID, err, response),
+		)
+		return
+	}
+
+	location := config.Location.ValueString()
+	if location == "" {
+		location = core.StringNilMapper(source.RegionID)
+	}
+	target, err := databaseDeploymentTarget(a.session, core.StringNilMapper(source.ResourcePlanID), location)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Find Deployment Target", err.Error())
+		return
+	}
+
+	resourceGroupID := core.StringNilMapper(source.ResourceGroupID)
+	if !config.ResourceGroupID.IsNull() {
+		resourceGroupID = config.ResourceGroupID.ValueString()
+	}
+
+	parameters, err := json.Marshal(params)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Build Deployment Parameters", err.Error())
+		return
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(parameters, &raw); err != nil {
+		resp.Diagnostics.AddError("Unable to Build Deployment Parameters", err.Error())
+		return
+	}
+
+	name := config.Name.ValueString()
+	instance, response, err := rsConClient.CreateResourceInstanceWithContext(ctx, &rc.CreateResourceInstanceOptions{
+		Name:           &name,
+		Target:         &target,
+		ResourceGroup:  &resourceGroupID,
+		ResourcePlanID: source.ResourcePlanID,
+		Parameters:     raw,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Deployment",
+			fmt.Sprintf("Error creating the restored deployment (%s): %s\n%s", name, err, response),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment '%s' created (%s), waiting for the restore to complete (timeout: %v)...", name, core.StringNilMapper(instance.CRN), waitTimeout),
+	})
+
+	if err := a.waitForRestore(ctx, rsConClient, cloudDatabasesClient, *instance.ID, waitTimeout, resp.SendProgress); err != nil {
+		resp.Diagnostics.AddError(
+			"Restore Failed",
+			fmt.Sprintf("The restore into deployment '%s' (%s) did not complete successfully: %s", name, core.StringNilMapper(instance.CRN), err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restore into deployment '%s' (%s) completed successfully", name, core.StringNilMapper(instance.CRN)),
+	})
+}
+
+// waitForRestore waits until the restored deployment is active and its
+// tasks are completed, reporting the state changes and the task progress.
+func (a *databasePointInTimeRestoreAction) waitForRestore(ctx context.Context, rsConClient *rc.ResourceControllerV2, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, instanceID string, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) error {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(databaseRestorePollInterval)
+	defer ticker.Stop()
+
+	lastState := ""
+	lastTask := ""
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("operation cancelled: %w", ctx.Err())
+		case <-deadline:
+			return fmt.Errorf("timeout after %v waiting for the restore to complete", timeout)
+		case <-ticker.C:
+		}
+
+		instance, response, err := rsConClient.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{
+			ID: &instanceID,
+		})
+		if err != nil || instance == nil {
+			if response != nil && response.StatusCode == 404 {
+				return fmt.Errorf("the deployment %s does not exist anymore", instanceID)
+			}
+			log.Printf("[DEBUG] Error getting the restored deployment (%s): %s\n%s", instanceID, err, response)
+			continue
+		}
+		state := core.StringNilMapper(instance.State)
+		if state != lastState {
+			sendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Deployment state: %s", state),
+			})
+			lastState = state
+		}
+		if state == databaseInstanceFailStatus {
+			return fmt.Errorf("the deployment %s failed", instanceID)
+		}
+
+		// The tasks can only be listed once the deployment is known to
+		// Cloud Databases, which may take a while after it was created.
+		tasks, response, err := cloudDatabasesClient.ListDeploymentTasksWithContext(ctx, &clouddatabasesv5.ListDeploymentTasksOptions{
+			ID: &instanceID,
+		})
+		if err != nil {
+			log.Printf("[DEBUG] Error listing the tasks of the restored deployment (%s): %s\n%s", instanceID, err, response)
+			continue
+		}
+
+		active := databaseRestoreActiveTask(tasks.Tasks)
+		if active != nil {
+			var progressPercent int64
+			if active.ProgressPercent != nil {
+				progressPercent = *active.ProgressPercent
+			}
+			progress := fmt.Sprintf("Task %s: %s (%d%%)", core.StringNilMapper(active.Description), core.StringNilMapper(active.Status), progressPercent)
+			if progress != lastTask {
+				sendProgress(action.InvokeProgressEvent{Message: progress})
+				lastTask = progress
+			}
+			continue
+		}
+		for _, task := range tasks.Tasks {
+			if core.StringNilMapper(task.Status) == databaseTaskFailedStatus {
+				return fmt.Errorf("task %s failed", core.StringNilMapper(task.Description))
+			}
+		}
+
+		if state == databaseInstanceSuccessStatus {
+			return nil
+		}
+	}
+}
+
+// databaseRestoreActiveTask returns the first queued or running task.
+func databaseRestoreActiveTask(tasks []clouddatabasesv5.Task) *clouddatabasesv5.Task {
+	for i, task := range tasks {
+		switch core.StringNilMapper(task.Status) {
+		case databaseTaskQueuedStatus, databaseTaskRunningStatus:
+			return &tasks[i]
+		}
+	}
+	return nil
+}
+
+// databaseDeploymentTarget returns the CRN of the catalog deployment of the
+// plan in the location.
+func databaseDeploymentTarget(session conns.ClientSession, planID string, location string) (string, error) {
+	rsCatClient, err := session.ResourceCatalogAPI()
+	if err != nil {
+		return "", err
+	}
+	deployments, err := rsCatClient.ResourceCatalog().ListDeployments(planID)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", planID, err)
+	}
+	deployments, supportedLocations := filterDatabaseDeployments(deployments, location)
+	if len(deployments) == 0 {
+		locationList := make([]string, 0, len(supportedLocations))
+		for l := range supportedLocations {
+			locationList = append(locationList, l)
+		}
+		return "", fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q", planID, location, locationList)
+	}
+	return deployments[0].CatalogCRN, nil
+}
+
+// databaseRestoreTimeBefore reports whether the point in time is before the
+// earliest point in time the deployment can be restored at.
+func databaseRestoreTimeBefore(pitrTime string, earliest string) bool {
+	t, err := time.Parse(time.RFC3339, pitrTime)
+	if err != nil {
+		return false
+	}
+	e, err := time.Parse(time.RFC3339, earliest)
+	if err != nil {
+		return false
+	}
+	return t.Before(e)
+}
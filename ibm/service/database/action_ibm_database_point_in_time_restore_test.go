@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccIBMDatabasePointInTimeRestoreActionBasic restores the most recent
+// state of a PostgreSQL deployment into a new deployment and deletes the
+// restored deployment afterwards.
+func TestAccIBMDatabasePointInTimeRestoreActionBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+	restoreName := fmt.Sprintf("%s-restore", testName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccCheckIBMDatabasePointInTimeRestoreDestroy(restoreName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabasePointInTimeRestoreActionConfig(testName, restoreName, "first restore"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_database.db", "name", testName),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabasePointInTimeRestoreActionConfig(testName, restoreName, "second restore"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMDatabaseRestored(restoreName),
+				),
+			},
+		},
+	})
+}
+
+// TestAccIBMDatabasePointInTimeRestoreActionInvalidConfig verifies that the
+// restore source is validated before the action is invoked.
+func TestAccIBMDatabasePointInTimeRestoreActionInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					action "ibm_database_point_in_time_restore" "test_action" {
+						config {
+							source_deployment_id = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/account:instance::"
+							name                 = "restore"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Restore Source"),
+			},
+			{
+				Config: `
+					action "ibm_database_point_in_time_restore" "test_action" {
+						config {
+							point_in_time_recovery_time = "2025-01-01T00:00:00Z"
+							name                        = "restore"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("Missing Source Deployment"),
+			},
+			{
+				Config: `
+					action "ibm_database_point_in_time_restore" "test_action" {
+						config {
+							source_deployment_id        = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/account:instance::"
+							point_in_time_recovery_time = "yesterday"
+							name                        = "restore"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Point In Time"),
+			},
+		},
+	})
+}
+
+func testAccIBMDatabaseRestoredInstances(name string) ([]rc.ResourceInstance, error) {
+	rsConClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	instances, _, err := rsConClient.ListResourceInstances(&rc.ListResourceInstancesOptions{
+		Name: &name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return instances.Resources, nil
+}
+
+func testAccCheckIBMDatabaseRestored(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		instances, err := testAccIBMDatabaseRestoredInstances(name)
+		if err != nil {
+			return err
+		}
+		for _, instance := range instances {
+			if instance.State != nil && *instance.State == "active" {
+				return nil
+			}
+		}
+		return fmt.Errorf("No active deployment named %s was restored", name)
+	}
+}
+
+// testAccCheckIBMDatabasePointInTimeRestoreDestroy deletes the restored
+// deployment, which is not managed by Terraform.
+func testAccCheckIBMDatabasePointInTimeRestoreDestroy(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rsConClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		instances, err := testAccIBMDatabaseRestoredInstances(name)
+		if err != nil {
+			return err
+		}
+		for _, instance := range instances {
+			if _, err := rsConClient.DeleteResourceInstance(&rc.DeleteResourceInstanceOptions{ID: instance.ID}); err != nil {
+				return fmt.Errorf("Error deleting the restored deployment %s: %s", *instance.ID, err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMDatabasePointInTimeRestoreActionConfig(name, restoreName, restore string) string {
+	return testAccCheckIBMDatabaseDataSourceConfig2(name) + fmt.Sprintf(`
+	action "ibm_database_point_in_time_restore" "test_action" {
+		config {
+			source_deployment_id        = ibm_database.db.id
+			point_in_time_recovery_time = ""
+			name                        = "%s"
+		}
+	}
+
+	resource "terraform_data" "restore" {
+		input = "%s"
+
+		lifecycle {
+			action_trigger {
+				events  = [after_update]
+				actions = [action.ibm_database_point_in_time_restore.test_action]
+			}
+		}
+	}`, restoreName, restore)
+}
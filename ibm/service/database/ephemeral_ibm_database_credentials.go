@@ -0,0 +1,501 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &databaseCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &databaseCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &databaseCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &databaseCredentialsEphemeralResource{}
+)
+
+const (
+	// databaseCredentialsPrivateKey is the private data key holding the user
+	// created in Open, so that Close can delete it again.
+	databaseCredentialsPrivateKey = "user"
+
+	// databaseCredentialsTimeout is how long to wait for the user tasks.
+	databaseCredentialsTimeout = 10 * time.Minute
+
+	databaseUserPasswordLength = 32
+	databaseUsernameLength     = 12
+)
+
+func NewDatabaseCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &databaseCredentialsEphemeralResource{}
+}
+
+type databaseCredentialsEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type databaseCredentialsModel struct {
+	DeploymentID  types.String `tfsdk:"deployment_id"`
+	UserType      types.String `tfsdk:"user_type"`
+	Username      types.String `tfsdk:"username"`
+	Rotate        types.Bool   `tfsdk:"rotate"`
+	Role          types.String `tfsdk:"role"`
+	EndpointType  types.String `tfsdk:"endpoint_type"`
+	Password      types.String `tfsdk:"password"`
+	URI           types.String `tfsdk:"uri"`
+	Host          types.String `tfsdk:"host"`
+	Port          types.Int64  `tfsdk:"port"`
+	CACertificate types.String `tfsdk:"ca_certificate"`
+}
+
+// databaseCredentialsPrivateData identifies the user to delete in Close.
+type databaseCredentialsPrivateData struct {
+	DeploymentID string `json:"deployment_id"`
+	UserType     string `json:"user_type"`
+	Username     string `json:"username"`
+}
+
+func (r *databaseCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_database_credentials"
+}
+
+func (r *databaseCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived Cloud Databases user, or rotates the password of an existing user, and returns its connection URI and CA certificate. The password is never persisted to the Terraform plan or state, and a created user is deleted when Terraform closes the ephemeral resource.",
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID or CRN of the Cloud Databases deployment.",
+			},
+			"user_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The type of the user: database or ops_manager. Defaults to database.",
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the user. A unique name is generated when it is not set.",
+			},
+			"rotate": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true, the password of the existing user named username is rotated instead of creating a user. The user is kept when the ephemeral resource is closed.",
+			},
+			"role": schema.StringAttribute{
+				Optional:    true,
+				Description: "The role of the user. Only supported for the Redis 6.0 and above RBAC roles, such as -@all +@read, and for the MongoDB Enterprise ops_manager roles.",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The endpoint type of the connection: public or private. Defaults to public.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated password of the user.",
+			},
+			"uri": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The connection URI of the deployment, including the username and the password.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The host name of the first host of the connection.",
+			},
+			"port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The port of the first host of the connection.",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM encoded CA certificate to verify the connection with.",
+			},
+		},
+	}
+}
+
+func (r *databaseCredentialsEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config databaseCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.UserType.IsNull() && !config.UserType.IsUnknown() {
+		switch userType := config.UserType.ValueString(); userType {
+		case "database", "ops_manager":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("user_type"),
+				"Invalid User Type",
+				fmt.Sprintf("The user_type must be one of database, ops_manager, got: %s", userType),
+			)
+		}
+	}
+
+	if !config.EndpointType.IsNull() && !config.EndpointType.IsUnknown() {
+		switch endpointType := config.EndpointType.ValueString(); endpointType {
+		case "public", "private":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint_type"),
+				"Invalid Endpoint Type",
+				fmt.Sprintf("The endpoint_type must be one of public, private, got: %s", endpointType),
+			)
+		}
+	}
+
+	if config.Rotate.ValueBool() && config.Username.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Missing Username",
+			"The username of the existing user must be set when rotate is set to true.",
+		)
+	}
+	if config.Rotate.ValueBool() && config.UserType.ValueString() == "ops_manager" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotate"),
+			"Unsupported Rotation",
+			"The password of an ops_manager user cannot be rotated.",
+		)
+	}
+}
+
+func (r *databaseCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.session = session
+}
+
+func (r *databaseCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.session == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Provider Session",
+			"The ibm_database_credentials ephemeral resource was opened before the provider was configured.",
+		)
+		return
+	}
+
+	var data databaseCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudDatabasesClient, err := r.session.CloudDatabasesV5()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Cloud Databases Client",
+			"An unexpected error occurred when creating the Cloud Databases client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Cloud Databases Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	deploymentID := data.DeploymentID.ValueString()
+	deploymentInfo, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(ctx, &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: &deploymentID,
+	})
+	if err != nil || deploymentInfo.Deployment == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Database Deployment",
+			fmt.Sprintf("Error getting the database deployment (%s): %s\n%s", deploymentID, err, response),
+		)
+		return
+	}
+	deployment := deploymentInfo.Deployment
+
+	user := &DatabaseUser{
+		Username: data.Username.ValueString(),
+		Type:     "database",
+	}
+	if !data.UserType.IsNull() {
+		user.Type = data.UserType.ValueString()
+	}
+	if user.Username == "" {
+		if user.Username, err = generateDatabaseUsername(); err != nil {
+			resp.Diagnostics.AddError("Unable to Generate Username", err.Error())
+			return
+		}
+	}
+	if user.Password, err = generateDatabaseUserPassword(user.Type); err != nil {
+		resp.Diagnostics.AddError("Unable to Generate Password", err.Error())
+		return
+	}
+	if !data.Role.IsNull() {
+		user.Role = data.Role.ValueStringPointer()
+	}
+
+	version, err := databaseMajorVersion(deployment.Version)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Database Version", err.Error())
+		return
+	}
+	if err := user.ValidateRole(core.StringNilMapper(deployment.Type), version); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role"), "Invalid Role", err.Error())
+		return
+	}
+
+	if data.Rotate.ValueBool() {
+		log.Printf("[INFO] Rotating the password of database (%s) user (%s)", deploymentID, user.Username)
+		if err := user.UpdateWithTimeout(deploymentID, r.session, databaseCredentialsTimeout); err != nil {
+			resp.Diagnostics.AddError("Unable to Rotate Database User Password", err.Error())
+			return
+		}
+	} else {
+		log.Printf("[INFO] Creating database (%s) user (%s)", deploymentID, user.Username)
+		if err := user.CreateWithTimeout(deploymentID, r.session, databaseCredentialsTimeout); err != nil {
+			resp.Diagnostics.AddError("Unable to Create Database User", err.Error())
+			return
+		}
+		// Close is not called when Open fails, the user is deleted right away.
+		defer func() {
+			if !resp.Diagnostics.HasError() {
+				return
+			}
+			if err := user.DeleteWithTimeout(deploymentID, r.session, databaseCredentialsTimeout); err != nil {
+				log.Printf("[WARN] Error deleting database (%s) user (%s): %s", deploymentID, user.Username, err)
+			}
+		}()
+
+		privateData, err := json.Marshal(databaseCredentialsPrivateData{
+			DeploymentID: deploymentID,
+			UserType:     user.Type,
+			Username:     user.Username,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Store Database User", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, databaseCredentialsPrivateKey, privateData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	endpointType := "public"
+	if !data.EndpointType.IsNull() {
+		endpointType = data.EndpointType.ValueString()
+	}
+	connection, response, err := cloudDatabasesClient.GetConnectionWithContext(ctx, &clouddatabasesv5.GetConnectionOptions{
+		ID:           &deploymentID,
+		UserType:     &user.Type,
+		UserID:       &user.Username,
+		EndpointType: &endpointType,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Database Connection",
+			fmt.Sprintf("GetConnectionWithContext failed: %s\n%s", err, response),
+		)
+		return
+	}
+
+	uri, err := databaseConnectionURI(connection.Connection.(*clouddatabasesv5.Connection), user.Type)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Database Connection", err.Error())
+		return
+	}
+
+	data.Username = types.StringValue(user.Username)
+	data.Password = types.StringValue(user.Password)
+	if len(uri.Composed) > 0 {
+		data.URI = types.StringValue(strings.ReplaceAll(uri.Composed[0], "$PASSWORD", user.Password))
+	} else {
+		data.URI = types.StringNull()
+	}
+	data.Host = types.StringNull()
+	data.Port = types.Int64Null()
+	if len(uri.Hosts) > 0 {
+		data.Host = types.StringPointerValue(uri.Hosts[0].Hostname)
+		data.Port = types.Int64PointerValue(uri.Hosts[0].Port)
+	}
+	data.CACertificate = types.StringNull()
+	if uri.Certificate != nil && uri.Certificate.CertificateBase64 != nil {
+		certificate, err := base64.StdEncoding.DecodeString(*uri.Certificate.CertificateBase64)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Decode CA Certificate", err.Error())
+			return
+		}
+		data.CACertificate = types.StringValue(string(certificate))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *databaseCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateData, diags := req.Private.GetKey(ctx, databaseCredentialsPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	var created databaseCredentialsPrivateData
+	if err := json.Unmarshal(privateData, &created); err != nil {
+		resp.Diagnostics.AddError("Unable to Read Database User", err.Error())
+		return
+	}
+
+	log.Printf("[INFO] Deleting database (%s) user (%s)", created.DeploymentID, created.Username)
+	user := &DatabaseUser{Username: created.Username, Type: created.UserType}
+	if err := user.DeleteWithTimeout(created.DeploymentID, r.session, databaseCredentialsTimeout); err != nil {
+		resp.Diagnostics.AddError("Unable to Delete Database User", err.Error())
+	}
+}
+
+// databaseConnectionURI returns the connection of the deployment that the
+// user connects to the database with, which depends on the database type.
+func databaseConnectionURI(conn *clouddatabasesv5.Connection, userType string) (*clouddatabasesv5.ConnectionURI, error) {
+	if userType == "ops_manager" {
+		if conn.OpsManager == nil {
+			return nil, fmt.Errorf("the deployment has no ops_manager connection")
+		}
+		return conn.OpsManager, nil
+	}
+
+	switch {
+	case conn.Postgres != nil:
+		return &clouddatabasesv5.ConnectionURI{
+			Composed:    conn.Postgres.Composed,
+			Hosts:       conn.Postgres.Hosts,
+			Certificate: conn.Postgres.Certificate,
+		}, nil
+	case conn.Rediss != nil:
+		return &clouddatabasesv5.ConnectionURI{
+			Composed:    conn.Rediss.Composed,
+			Hosts:       conn.Rediss.Hosts,
+			Certificate: conn.Rediss.Certificate,
+		}, nil
+	case conn.Mongodb != nil:
+		return &clouddatabasesv5.ConnectionURI{
+			Composed:    conn.Mongodb.Composed,
+			Hosts:       conn.Mongodb.Hosts,
+			Certificate: conn.Mongodb.Certificate,
+		}, nil
+	case conn.Mysql != nil:
+		return &clouddatabasesv5.ConnectionURI{
+			Composed:    conn.Mysql.Composed,
+			Hosts:       conn.Mysql.Hosts,
+			Certificate: conn.Mysql.Certificate,
+		}, nil
+	case conn.Amqps != nil:
+		return conn.Amqps, nil
+	case conn.HTTPS != nil:
+		return conn.HTTPS, nil
+	case conn.Grpc != nil:
+		return conn.Grpc, nil
+	case conn.Emp != nil:
+		return conn.Emp, nil
+	}
+	return nil, fmt.Errorf("the deployment has no supported connection")
+}
+
+// databaseMajorVersion returns the major version of a deployment version such
+// as 6.2, or 0 when the version is not known.
+func databaseMajorVersion(version *string) (int, error) {
+	if version == nil || *version == "" {
+		return 0, nil
+	}
+	major, err := strconv.Atoi(strings.SplitN(*version, ".", 2)[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid version: %s", *version)
+	}
+	return major, nil
+}
+
+// generateDatabaseUsername returns a unique name for a short-lived user.
+func generateDatabaseUsername() (string, error) {
+	suffix, err := randomDatabaseString("abcdefghijklmnopqrstuvwxyz0123456789", databaseUsernameLength)
+	if err != nil {
+		return "", err
+	}
+	return "tf_" + suffix, nil
+}
+
+// generateDatabaseUserPassword returns a random password that passes
+// ValidatePassword for the user type. It only contains characters that do
+// not need to be escaped in a connection URI.
+func generateDatabaseUserPassword(userType string) (string, error) {
+	const (
+		lower  = "abcdefghijklmnopqrstuvwxyz"
+		upper  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		digits = "0123456789"
+	)
+	// The password starts with a letter and contains a letter of each case,
+	// a number and a special character, wherever they are.
+	first, err := randomDatabaseString(lower+upper, 1)
+	if err != nil {
+		return "", err
+	}
+	required := []string{lower, upper, digits, databaseUserSpecialChars}
+	password := []byte(first)
+	for _, chars := range required {
+		c, err := randomDatabaseString(chars, 1)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c...)
+	}
+	rest, err := randomDatabaseString(lower+upper+digits, databaseUserPasswordLength-len(password))
+	if err != nil {
+		return "", err
+	}
+	password = append(password, rest...)
+
+	// Shuffle all but the first character.
+	for i := len(password) - 1; i > 1; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i)))
+		if err != nil {
+			return "", err
+		}
+		k := int(j.Int64()) + 1
+		password[i], password[k] = password[k], password[i]
+	}
+
+	user := &DatabaseUser{Username: "admin", Type: userType, Password: string(password)}
+	if err := user.ValidatePassword(); err != nil {
+		return "", err
+	}
+	return string(password), nil
+}
+
+func randomDatabaseString(chars string, length int) (string, error) {
+	b := make([]byte, length)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+		if err != nil {
+			return "", err
+		}
+		b[i] = chars[n.Int64()]
+	}
+	return string(b), nil
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func TestAccIBMDatabaseCredentialsEphemeralResource_Basic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+	// The provisioner writes the generated username, never the password, to
+	// check that the user is deleted when the ephemeral resource is closed.
+	usernameFile := filepath.Join(t.TempDir(), "username")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseCredentialsEphemeralResourceConfig(testName, usernameFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_database.db", "name", testName),
+					resource.TestCheckResourceAttr("ibm_database.db", "service", "databases-for-postgresql"),
+					testAccCheckIBMDatabaseCredentialsUserDeleted("ibm_database.db", usernameFile),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMDatabaseCredentialsEphemeralResource_InvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					ephemeral "ibm_database_credentials" "credentials" {
+						deployment_id = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/account:instance::"
+						rotate        = true
+					}
+				`,
+				ExpectError: regexp.MustCompile("Missing Username"),
+			},
+			{
+				Config: `
+					ephemeral "ibm_database_credentials" "credentials" {
+						deployment_id = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/account:instance::"
+						endpoint_type = "internal"
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Endpoint Type"),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMDatabaseCredentialsUserDeleted checks that the user created
+// by the ephemeral resource no longer exists once the run is over.
+func testAccCheckIBMDatabaseCredentialsUserDeleted(deployment, usernameFile string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[deployment]
+		if !ok {
+			return fmt.Errorf("Not found: %s", deployment)
+		}
+		username, err := os.ReadFile(usernameFile)
+		if err != nil {
+			return fmt.Errorf("The credentials were not passed to the provisioner: %s", err)
+		}
+
+		cloudDatabasesClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).CloudDatabasesV5()
+		if err != nil {
+			return err
+		}
+		userType, user := "database", string(username)
+		_, response, err := cloudDatabasesClient.DeleteDatabaseUser(&clouddatabasesv5.DeleteDatabaseUserOptions{
+			ID:       &rs.Primary.ID,
+			UserType: &userType,
+			Username: &user,
+		})
+		if err == nil {
+			return fmt.Errorf("Database user %s still existed after the ephemeral resource was closed", user)
+		}
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Error checking database user %s: %s", user, err)
+		}
+		return nil
+	}
+}
+
+// The uri is passed to a provisioner, which accepts ephemeral values, and
+// fails the apply unless it is the connection URI of the generated user.
+func testAccCheckIBMDatabaseCredentialsEphemeralResourceConfig(name, usernameFile string) string {
+	return testAccCheckIBMDatabaseDataSourceConfig2(name) + fmt.Sprintf(`
+		ephemeral "ibm_database_credentials" "credentials" {
+			deployment_id = ibm_database.db.id
+			endpoint_type = "public"
+		}
+
+		resource "terraform_data" "credentials" {
+			provisioner "local-exec" {
+				interpreter = ["/bin/sh", "-c"]
+				command     = "case \"$URI\" in postgres://\"$USERNAME\":?*@?*) printf '%%s' \"$USERNAME\" > '%[1]s' ;; *) echo 'unexpected uri' >&2; exit 1 ;; esac"
+				environment = {
+					URI      = ephemeral.ibm_database_credentials.credentials.uri
+					USERNAME = ephemeral.ibm_database_credentials.credentials.username
+				}
+			}
+		}
+	`, usernameFile)
+}
@@ -3189,20 +3189,7 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 				return err
 			}
 
-			// TODO: Use Capability API
-			// RBAC roles supported for Redis 6.0 and above
-			if (service == "databases-for-redis") && !(version > 0 && version < 6) {
-				err = change.New.ValidateRBACRole()
-			} else if service == "databases-for-mongodb" && change.New.Type == "ops_manager" {
-				err = change.New.ValidateOpsManagerRole()
-			} else {
-				if change.New.Role != nil {
-					if *change.New.Role != "" {
-						err = errors.New("role is not supported for this deployment or user type")
-						err = &databaseUserValidationError{user: change.New, errs: []error{err}}
-					}
-				}
-			}
+			err = change.New.ValidateRole(getDatabaseTypeFromResourceID(service), version)
 
 			if err != nil {
 				return err
@@ -3341,6 +3328,11 @@ func (u *DatabaseUser) ID() (id string) {
 }
 
 func (u *DatabaseUser) Create(instanceID string, d *schema.ResourceData, meta interface{}) (err error) {
+	return u.CreateWithTimeout(instanceID, meta, d.Timeout(schema.TimeoutUpdate))
+}
+
+// CreateWithTimeout creates the user and waits up to timeout for the task to complete.
+func (u *DatabaseUser) CreateWithTimeout(instanceID string, meta interface{}, timeout time.Duration) (err error) {
 	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting database client settings: %w", err)
@@ -3369,7 +3361,7 @@ func (u *DatabaseUser) Create(instanceID string, d *schema.ResourceData, meta in
 	}
 
 	taskID := *createDatabaseUserResponse.Task.ID
-	_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+	_, err = waitForDatabaseTaskComplete(taskID, nil, meta, timeout)
 
 	if err != nil {
 		return fmt.Errorf(
@@ -3380,6 +3372,12 @@ func (u *DatabaseUser) Create(instanceID string, d *schema.ResourceData, meta in
 }
 
 func (u *DatabaseUser) Update(instanceID string, d *schema.ResourceData, meta interface{}) (err error) {
+	return u.UpdateWithTimeout(instanceID, meta, d.Timeout(schema.TimeoutUpdate))
+}
+
+// UpdateWithTimeout updates the password and the role of the user and waits
+// up to timeout for the task to complete.
+func (u *DatabaseUser) UpdateWithTimeout(instanceID string, meta interface{}, timeout time.Duration) (err error) {
 	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
@@ -3409,7 +3407,7 @@ func (u *DatabaseUser) Update(instanceID string, d *schema.ResourceData, meta in
 	}
 
 	taskID := *updateUserResponse.Task.ID
-	_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+	_, err = waitForDatabaseTaskComplete(taskID, nil, meta, timeout)
 
 	if err != nil {
 		return fmt.Errorf(
@@ -3420,6 +3418,11 @@ func (u *DatabaseUser) Update(instanceID string, d *schema.ResourceData, meta in
 }
 
 func (u *DatabaseUser) Delete(instanceID string, d *schema.ResourceData, meta interface{}) (err error) {
+	return u.DeleteWithTimeout(instanceID, meta, d.Timeout(schema.TimeoutUpdate))
+}
+
+// DeleteWithTimeout deletes the user and waits up to timeout for the task to complete.
+func (u *DatabaseUser) DeleteWithTimeout(instanceID string, meta interface{}, timeout time.Duration) (err error) {
 	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
@@ -3440,7 +3443,7 @@ func (u *DatabaseUser) Delete(instanceID string, d *schema.ResourceData, meta in
 	}
 
 	taskID := *deleteDatabaseUserResponse.Task.ID
-	_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+	_, err = waitForDatabaseTaskComplete(taskID, nil, meta, timeout)
 
 	if err != nil {
 		return fmt.Errorf(
@@ -3585,6 +3588,27 @@ func (u *DatabaseUser) ValidateOpsManagerRole() (err error) {
 	return &databaseUserValidationError{user: u, errs: []error{err}}
 }
 
+// ValidateRole validates the role of the user for the database type, such as
+// redis, and the major version of the deployment, 0 being the latest version.
+func (u *DatabaseUser) ValidateRole(databaseType string, version int) (err error) {
+	// TODO: Use Capability API
+	// RBAC roles supported for Redis 6.0 and above
+	if databaseType == "redis" && !(version > 0 && version < 6) {
+		return u.ValidateRBACRole()
+	}
+
+	if databaseType == "mongodb" && u.Type == "ops_manager" {
+		return u.ValidateOpsManagerRole()
+	}
+
+	if u.Role != nil && *u.Role != "" {
+		err = errors.New("role is not supported for this deployment or user type")
+		return &databaseUserValidationError{user: u, errs: []error{err}}
+	}
+
+	return nil
+}
+
 func DatabaseUserPasswordValidator(userType string) schema.SchemaValidateFunc {
 	return func(i interface{}, k string) (warnings []string, errors []error) {
 		user := &DatabaseUser{Username: "admin", Type: userType, Password: i.(string)}
@@ -201,6 +201,105 @@ func TestValidateRBACRole(t *testing.T) {
 	}
 }
 
+func TestValidateRole(t *testing.T) {
+	testcases := []struct {
+		databaseType  string
+		version       int
+		user          DatabaseUser
+		expectedError string
+	}{
+		{
+			databaseType: "redis",
+			version:      0,
+			user: DatabaseUser{
+				Username: "redis_latest",
+				Type:     "database",
+				Role:     core.StringPtr("-@all +@read"),
+			},
+			expectedError: "",
+		},
+		{
+			databaseType: "redis",
+			version:      6,
+			user: DatabaseUser{
+				Username: "redis_invalid",
+				Type:     "database",
+				Role:     core.StringPtr("+@catfood"),
+			},
+			expectedError: "database user (redis_invalid) validation error:\nrole must contain only allowed categories: all,admin,read,write",
+		},
+		{
+			databaseType: "redis",
+			version:      5,
+			user: DatabaseUser{
+				Username: "redis_5",
+				Type:     "database",
+				Role:     core.StringPtr("-@all +@read"),
+			},
+			expectedError: "database user (redis_5) validation error:\nrole is not supported for this deployment or user type",
+		},
+		{
+			databaseType: "mongodb",
+			version:      0,
+			user: DatabaseUser{
+				Username: "ops_manager_valid",
+				Type:     "ops_manager",
+				Role:     core.StringPtr("group_read_only"),
+			},
+			expectedError: "",
+		},
+		{
+			databaseType: "mongodb",
+			version:      0,
+			user: DatabaseUser{
+				Username: "ops_manager_invalid",
+				Type:     "ops_manager",
+				Role:     core.StringPtr("group_owner"),
+			},
+			expectedError: "database user (ops_manager_invalid) validation error:\nrole must be a valid ops_manager role: group_read_only,group_data_access_admin",
+		},
+		{
+			databaseType: "postgresql",
+			version:      16,
+			user: DatabaseUser{
+				Username: "postgres_role",
+				Type:     "database",
+				Role:     core.StringPtr("+@all"),
+			},
+			expectedError: "database user (postgres_role) validation error:\nrole is not supported for this deployment or user type",
+		},
+		{
+			databaseType: "postgresql",
+			version:      16,
+			user: DatabaseUser{
+				Username: "postgres_no_role",
+				Type:     "database",
+			},
+			expectedError: "",
+		},
+	}
+	for _, tc := range testcases {
+		err := tc.user.ValidateRole(tc.databaseType, tc.version)
+
+		var errMsg string
+		if err != nil {
+			errMsg = err.Error()
+		}
+
+		assert.Equal(t, tc.expectedError, errMsg)
+	}
+}
+
+func TestGenerateDatabaseUserPassword(t *testing.T) {
+	for _, userType := range []string{"database", "ops_manager"} {
+		for i := 0; i < 100; i++ {
+			password, err := generateDatabaseUserPassword(userType)
+			assert.NilError(t, err)
+			assert.Equal(t, databaseUserPasswordLength, len(password))
+		}
+	}
+}
+
 func TestPublicServiceEndpointsWarning(t *testing.T) {
 	diags := publicServiceEndpointsWarning()
 	warningNote := "IBM recommends using private endpoints only to improve security by restricting access to your database to the IBM Cloud private network. For more information, please refer to our security best practices, https://cloud.ibm.com/docs/cloud-databases?topic=cloud-databases-manage-security-compliance."
@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMEventStreamsConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMEventStreamsConsumerGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the consumer groups to read, all the consumer groups by default",
+			},
+			"consumer_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The consumer groups",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the consumer group",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the consumer group, such as Stable or Empty",
+						},
+						"members": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of members of the consumer group",
+						},
+						"total_lag": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The sum of the lag of all the partitions",
+						},
+						"partitions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The partitions the consumer group has committed offsets for",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the topic",
+									},
+									"partition": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The partition",
+									},
+									"offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The committed offset",
+									},
+									"end_offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The offset of the next message produced to the partition",
+									},
+									"lag": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The number of messages the consumer group has not consumed",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEventStreamsConsumerGroupsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMEventStreamsConsumerGroupsRead createSaramaAdminClient: %s", err), "ibm_event_streams_consumer_groups", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer adminClient.Release()
+	names := flex.ExpandStringList(d.Get("names").([]interface{}))
+	if len(names) == 0 {
+		groups, err := adminClient.ListConsumerGroups()
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMEventStreamsConsumerGroupsRead ListConsumerGroups: %s", err), "ibm_event_streams_consumer_groups", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		for name := range groups {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	consumerGroups := []map[string]interface{}{}
+	if len(names) > 0 {
+		descriptions, err := adminClient.DescribeConsumerGroups(names)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMEventStreamsConsumerGroupsRead DescribeConsumerGroups: %s", err), "ibm_event_streams_consumer_groups", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+
+		// The end offsets are shared by the groups consuming the same topics.
+		endOffsets := map[string]int64{}
+		for _, description := range descriptions {
+			consumerGroup, err := flattenConsumerGroup(adminClient, adminClient.Client(), description, endOffsets)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMEventStreamsConsumerGroupsRead: %s", err), "ibm_event_streams_consumer_groups", "read")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			consumerGroups = append(consumerGroups, consumerGroup)
+		}
+	}
+
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "consumergroups"
+	d.SetId(strings.Join(crnSegments, ":"))
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("consumer_groups", consumerGroups)
+	return nil
+}
+
+func flattenConsumerGroup(adminClient sarama.ClusterAdmin, client sarama.Client, description *sarama.GroupDescription, endOffsets map[string]int64) (map[string]interface{}, error) {
+	group := description.GroupId
+	if description.Err != sarama.ErrNoError {
+		return nil, fmt.Errorf("DescribeConsumerGroups %s: %s", group, description.Err)
+	}
+	offsets, err := listConsumerGroupOffsets(adminClient, group)
+	if err != nil {
+		return nil, fmt.Errorf("ListConsumerGroupOffsets %s: %s", group, err)
+	}
+
+	var totalLag int64
+	partitions := []map[string]interface{}{}
+	for _, offset := range offsets {
+		key := fmt.Sprintf("%s/%d", offset.topic, offset.partition)
+		endOffset, ok := endOffsets[key]
+		if !ok {
+			if endOffset, err = client.GetOffset(offset.topic, offset.partition, sarama.OffsetNewest); err != nil {
+				return nil, fmt.Errorf("GetOffset %s: %s", key, err)
+			}
+			endOffsets[key] = endOffset
+		}
+		lag := max(endOffset-offset.offset, 0)
+		totalLag += lag
+		partitions = append(partitions, map[string]interface{}{
+			"topic":      offset.topic,
+			"partition":  int(offset.partition),
+			"offset":     int(offset.offset),
+			"end_offset": int(endOffset),
+			"lag":        int(lag),
+		})
+	}
+	return map[string]interface{}{
+		"name":       group,
+		"state":      description.State,
+		"members":    len(description.Members),
+		"total_lag":  int(totalLag),
+		"partitions": partitions,
+	}, nil
+}
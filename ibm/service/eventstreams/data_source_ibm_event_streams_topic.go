@@ -59,6 +59,7 @@ func dataSourceIBMEventStreamsTopicRead(context context.Context, d *schema.Resou
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	defer adminClient.Release()
 	topics, err := adminClient.ListTopics()
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMEventStreamsTopicRead ListTopics: %s", err), "ibm_event_streams_topic", "read")
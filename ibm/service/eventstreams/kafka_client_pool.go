@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// adminClientIdleTimeout is how long the Kafka clients of an instance are
+// kept open after they were last released.
+var adminClientIdleTimeout = 5 * time.Minute
+
+// clientPool maintains the Kafka clients of each instance, shared by all the
+// Kafka-protocol resources and data sources.
+// key is instance's CRN
+var clientPool = newKafkaClientPool(adminClientIdleTimeout)
+
+// CloseClientPool closes the Kafka clients of all the instances. It is called
+// when the provider shuts down.
+func CloseClientPool() {
+	clientPool.Close()
+}
+
+// kafkaAdminClient is a Kafka admin client borrowed from the pool. The
+// caller releases it once it is done with it.
+type kafkaAdminClient struct {
+	sarama.ClusterAdmin
+	client  sarama.Client
+	release func()
+}
+
+// Client returns the Kafka client the admin client was created from, for
+// the requests that the admin client does not support, such as listing and
+// committing offsets. The client must not be closed.
+func (c *kafkaAdminClient) Client() sarama.Client {
+	return c.client
+}
+
+// Release returns the client to the pool. It may be called more than once.
+func (c *kafkaAdminClient) Release() {
+	c.release()
+}
+
+type pooledKafkaClient struct {
+	ready    chan struct{}
+	client   sarama.Client
+	admin    sarama.ClusterAdmin
+	err      error
+	refs     int
+	lastUsed time.Time
+}
+
+// kafkaClientPool is a concurrency safe cache of Kafka clients keyed by
+// instance CRN. A client is created once even if it is requested by several
+// resources at the same time, and is closed once it has not been used for
+// the idle timeout.
+type kafkaClientPool struct {
+	mu          sync.Mutex
+	clients     map[string]*pooledKafkaClient
+	idleTimeout time.Duration
+	timer       *time.Timer
+	closed      bool
+}
+
+func newKafkaClientPool(idleTimeout time.Duration) *kafkaClientPool {
+	return &kafkaClientPool{
+		clients:     map[string]*pooledKafkaClient{},
+		idleTimeout: idleTimeout,
+	}
+}
+
+// Get returns the pooled client of the instance, creating it with create when
+// the pool has none. A failed creation is not cached.
+func (p *kafkaClientPool) Get(instanceCRN string, create func() (sarama.Client, sarama.ClusterAdmin, error)) (*kafkaAdminClient, error) {
+	p.mu.Lock()
+	pc, ok := p.clients[instanceCRN]
+	if ok && pc.closedByKafka() {
+		// The client was closed after a fatal error, it is replaced.
+		delete(p.clients, instanceCRN)
+		ok = false
+	}
+	if !ok {
+		pc = &pooledKafkaClient{ready: make(chan struct{})}
+		p.clients[instanceCRN] = pc
+		pc.refs++
+		p.mu.Unlock()
+
+		pc.client, pc.admin, pc.err = create()
+		close(pc.ready)
+		if pc.err == nil {
+			log.Printf("[INFO] createSaramaAdminClient instance %s 's client is initialized", instanceCRN)
+		}
+	} else {
+		pc.refs++
+		p.mu.Unlock()
+		<-pc.ready
+		log.Printf("[DEBUG] createSaramaAdminClient got client from pool for instance %s", instanceCRN)
+	}
+
+	if pc.err != nil {
+		p.mu.Lock()
+		pc.refs--
+		if p.clients[instanceCRN] == pc {
+			delete(p.clients, instanceCRN)
+		}
+		p.mu.Unlock()
+		return nil, pc.err
+	}
+
+	var once sync.Once
+	return &kafkaAdminClient{
+		ClusterAdmin: pc.admin,
+		client:       pc.client,
+		release: func() {
+			once.Do(func() { p.release(pc) })
+		},
+	}, nil
+}
+
+func (pc *pooledKafkaClient) closedByKafka() bool {
+	select {
+	case <-pc.ready:
+		return pc.err == nil && pc.client.Closed()
+	default:
+		return false
+	}
+}
+
+func (p *kafkaClientPool) release(pc *pooledKafkaClient) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pc.refs--
+	pc.lastUsed = time.Now()
+	if pc.refs == 0 && p.timer == nil && !p.closed {
+		p.timer = time.AfterFunc(p.idleTimeout, p.evictIdle)
+	}
+}
+
+// evictIdle closes the clients that have not been used for the idle timeout,
+// and schedules the next eviction when clients are left idle.
+func (p *kafkaClientPool) evictIdle() {
+	p.mu.Lock()
+	p.timer = nil
+	now := time.Now()
+	var evicted []sarama.ClusterAdmin
+	var next time.Duration
+	for instanceCRN, pc := range p.clients {
+		if pc.refs > 0 {
+			continue
+		}
+		idle := now.Sub(pc.lastUsed)
+		if idle >= p.idleTimeout {
+			log.Printf("[DEBUG] Closing idle Kafka client of instance %s", instanceCRN)
+			delete(p.clients, instanceCRN)
+			evicted = append(evicted, pc.admin)
+		} else if remaining := p.idleTimeout - idle; next == 0 || remaining < next {
+			next = remaining
+		}
+	}
+	if next > 0 && !p.closed {
+		p.timer = time.AfterFunc(next, p.evictIdle)
+	}
+	p.mu.Unlock()
+
+	closeKafkaAdminClients(evicted)
+}
+
+// Close closes all the clients of the pool. Clients that are still borrowed
+// are closed as well, since the provider is shutting down.
+func (p *kafkaClientPool) Close() {
+	p.mu.Lock()
+	p.closed = true
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	var admins []sarama.ClusterAdmin
+	for instanceCRN, pc := range p.clients {
+		select {
+		case <-pc.ready:
+			if pc.err == nil {
+				admins = append(admins, pc.admin)
+			}
+		default:
+			// The client is still being created, and is left to the process exit.
+		}
+		delete(p.clients, instanceCRN)
+	}
+	p.mu.Unlock()
+
+	closeKafkaAdminClients(admins)
+}
+
+// closeKafkaAdminClients closes the admin clients, which also closes the
+// clients they were created from.
+func closeKafkaAdminClients(admins []sarama.ClusterAdmin) {
+	for _, admin := range admins {
+		if err := admin.Close(); err != nil {
+			log.Printf("[WARN] Error closing Kafka client: %s", err)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeKafkaClient struct {
+	sarama.Client
+	closed atomic.Bool
+}
+
+func (c *fakeKafkaClient) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+func (c *fakeKafkaClient) Closed() bool {
+	return c.closed.Load()
+}
+
+type fakeClusterAdmin struct {
+	sarama.ClusterAdmin
+	client *fakeKafkaClient
+}
+
+func (a *fakeClusterAdmin) Close() error {
+	return a.client.Close()
+}
+
+func newFakeKafkaClients(created *atomic.Int32) func() (sarama.Client, sarama.ClusterAdmin, error) {
+	return func() (sarama.Client, sarama.ClusterAdmin, error) {
+		created.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		client := &fakeKafkaClient{}
+		return client, &fakeClusterAdmin{client: client}, nil
+	}
+}
+
+func TestKafkaClientPoolCreatesOneClientPerInstance(t *testing.T) {
+	pool := newKafkaClientPool(time.Minute)
+	defer pool.Close()
+	var created atomic.Int32
+	create := newFakeKafkaClients(&created)
+
+	var wg sync.WaitGroup
+	clients := make([]*kafkaAdminClient, 10)
+	for i := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client, err := pool.Get("crn:a", create)
+			assert.NoError(t, err)
+			clients[i] = client
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), created.Load())
+	for _, client := range clients {
+		require.NotNil(t, client)
+		assert.Same(t, clients[0].Client(), client.Client())
+		client.Release()
+	}
+
+	other, err := pool.Get("crn:b", create)
+	require.NoError(t, err)
+	other.Release()
+	assert.Equal(t, int32(2), created.Load())
+}
+
+func TestKafkaClientPoolDoesNotCacheErrors(t *testing.T) {
+	pool := newKafkaClientPool(time.Minute)
+	defer pool.Close()
+
+	_, err := pool.Get("crn:a", func() (sarama.Client, sarama.ClusterAdmin, error) {
+		return nil, nil, errors.New("brokers unreachable")
+	})
+	assert.EqualError(t, err, "brokers unreachable")
+
+	var created atomic.Int32
+	client, err := pool.Get("crn:a", newFakeKafkaClients(&created))
+	require.NoError(t, err)
+	client.Release()
+	assert.Equal(t, int32(1), created.Load())
+}
+
+func TestKafkaClientPoolReplacesClosedClients(t *testing.T) {
+	pool := newKafkaClientPool(time.Minute)
+	defer pool.Close()
+	var created atomic.Int32
+	create := newFakeKafkaClients(&created)
+
+	client, err := pool.Get("crn:a", create)
+	require.NoError(t, err)
+	client.Close()
+	client.Release()
+
+	client, err = pool.Get("crn:a", create)
+	require.NoError(t, err)
+	assert.False(t, client.Client().Closed())
+	client.Release()
+	assert.Equal(t, int32(2), created.Load())
+}
+
+func TestKafkaClientPoolEvictsIdleClients(t *testing.T) {
+	pool := newKafkaClientPool(50 * time.Millisecond)
+	defer pool.Close()
+	var created atomic.Int32
+	create := newFakeKafkaClients(&created)
+
+	idle, err := pool.Get("crn:a", create)
+	require.NoError(t, err)
+	busy, err := pool.Get("crn:b", create)
+	require.NoError(t, err)
+	idle.Release()
+	idle.Release()
+
+	assert.Eventually(t, func() bool {
+		return idle.Client().Closed()
+	}, time.Second, 10*time.Millisecond)
+	assert.False(t, busy.Client().Closed())
+
+	busy.Release()
+	assert.Eventually(t, func() bool {
+		return busy.Client().Closed()
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestKafkaClientPoolClose(t *testing.T) {
+	pool := newKafkaClientPool(time.Minute)
+	var created atomic.Int32
+	create := newFakeKafkaClients(&created)
+
+	a, err := pool.Get("crn:a", create)
+	require.NoError(t, err)
+	b, err := pool.Get("crn:b", create)
+	require.NoError(t, err)
+	b.Release()
+
+	pool.Close()
+	assert.True(t, a.Client().Closed())
+	assert.True(t, b.Client().Closed())
+	a.Release()
+}
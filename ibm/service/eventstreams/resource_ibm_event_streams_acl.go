@@ -0,0 +1,381 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	aclResourceTypes = []string{"topic", "group", "cluster", "transactional_id"}
+	aclPatternTypes  = []string{"literal", "prefixed"}
+	aclOperations    = []string{
+		"all",
+		"read",
+		"write",
+		"create",
+		"delete",
+		"alter",
+		"describe",
+		"cluster_action",
+		"describe_configs",
+		"alter_configs",
+		"idempotent_write",
+	}
+	aclPermissionTypes = []string{"allow", "deny"}
+)
+
+// The Kafka ACLs of a resource pattern in an Event Streams service instance.
+// The ID is the CRN with the last two components "acl:resource_type/pattern_type/resource_name".
+// The resource is authoritative: the ACLs of the resource pattern that are not
+// configured are deleted.
+func ResourceIBMEventStreamsACL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsACLCreate,
+		ReadContext:   resourceIBMEventStreamsACLRead,
+		UpdateContext: resourceIBMEventStreamsACLUpdate,
+		DeleteContext: resourceIBMEventStreamsACLDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The CRN of the Event Streams instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(aclResourceTypes, false),
+				Description:  "The type of the Kafka resource: topic, group, cluster or transactional_id",
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the Kafka resource, or the prefix of the names when pattern_type is prefixed. The name of the cluster resource is kafka-cluster",
+			},
+			"pattern_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "literal",
+				ValidateFunc: validation.StringInSlice(aclPatternTypes, false),
+				Description:  "The pattern type of the resource name: literal or prefixed",
+			},
+			"acl": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The ACLs of the resource pattern",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The principal, such as User:iam-ServiceId-00000000-0000-0000-0000-000000000000",
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "*",
+							Description: "The host the principal connects from, * for any host",
+						},
+						"operation": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(aclOperations, false),
+							Description:  "The operation: " + strings.Join(aclOperations, ", "),
+						},
+						"permission_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "allow",
+							ValidateFunc: validation.StringInSlice(aclPermissionTypes, false),
+							Description:  "The permission type: allow or deny",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsACLCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsACLCreate createSaramaAdminClient: %s", err), "ibm_event_streams_acl", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer adminClient.Release()
+	resource, err := expandACLResource(d)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_event_streams_acl", "create")
+		return tfErr.GetDiag()
+	}
+	acls, err := expandACLs(d.Get("acl").(*schema.Set).List())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_event_streams_acl", "create")
+		return tfErr.GetDiag()
+	}
+	err = adminClient.CreateACLs([]*sarama.ResourceAcls{{Resource: resource, Acls: acls}})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsACLCreate CreateACLs: %s", err), "ibm_event_streams_acl", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId(getACLID(instanceCRN, d.Get("resource_type").(string), d.Get("pattern_type").(string), d.Get("resource_name").(string)))
+	log.Printf("[INFO] resourceIBMEventStreamsACLCreate created %d ACLs for %s", len(acls), d.Id())
+	return resourceIBMEventStreamsACLRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsACLRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, resourceType, patternType, resourceName, err := parseACLID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_event_streams_acl", "read")
+		return tfErr.GetDiag()
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("resource_type", resourceType)
+	d.Set("pattern_type", patternType)
+	d.Set("resource_name", resourceName)
+
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsACLRead createSaramaAdminClient: %s", err), "ibm_event_streams_acl", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer adminClient.Release()
+	resource, err := expandACLResource(d)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_event_streams_acl", "read")
+		return tfErr.GetDiag()
+	}
+	resourceAcls, err := adminClient.ListAcls(sarama.AclFilter{
+		ResourceType:              resource.ResourceType,
+		ResourceName:              &resource.ResourceName,
+		ResourcePatternTypeFilter: resource.ResourcePatternType,
+		Operation:                 sarama.AclOperationAny,
+		PermissionType:            sarama.AclPermissionAny,
+	})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsACLRead ListAcls: %s", err), "ibm_event_streams_acl", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	acls := []interface{}{}
+	for _, ra := range resourceAcls {
+		for _, acl := range ra.Acls {
+			acls = append(acls, flattenACL(acl))
+		}
+	}
+	if len(acls) == 0 {
+		log.Printf("[INFO] resourceIBMEventStreamsACLRead no ACLs exist for %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+	d.Set("acl", acls)
+	return nil
+}
+
+func resourceIBMEventStreamsACLUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChange("acl") {
+		return resourceIBMEventStreamsACLRead(context, d, meta)
+	}
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsACLUpdate createSaramaAdminClient: %s", err), "ibm_event_streams_acl", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer adminClient.Release()
+	resource, err := expandACLResource(d)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_event_streams_acl", "update")
+		return tfErr.GetDiag()
+	}
+	o, n := d.GetChange("acl")
+	added, err := expandACLs(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_event_streams_acl", "update")
+		return tfErr.GetDiag()
+	}
+	removed, err := expandACLs(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_event_streams_acl", "update")
+		return tfErr.GetDiag()
+	}
+	// The new ACLs are created first, so that the principals do not lose
+	// access when an ACL is replaced.
+	if len(added) > 0 {
+		err = adminClient.CreateACLs([]*sarama.ResourceAcls{{Resource: resource, Acls: added}})
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsACLUpdate CreateACLs: %s", err), "ibm_event_streams_acl", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	if err := deleteACLs(adminClient, resource, removed); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsACLUpdate DeleteACL: %s", err), "ibm_event_streams_acl", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	log.Printf("[INFO] resourceIBMEventStreamsACLUpdate created %d and deleted %d ACLs for %s", len(added), len(removed), d.Id())
+	return resourceIBMEventStreamsACLRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsACLDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsACLDelete createSaramaAdminClient: %s", err), "ibm_event_streams_acl", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer adminClient.Release()
+	resource, err := expandACLResource(d)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_event_streams_acl", "delete")
+		return tfErr.GetDiag()
+	}
+	acls, err := expandACLs(d.Get("acl").(*schema.Set).List())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_event_streams_acl", "delete")
+		return tfErr.GetDiag()
+	}
+	if err := deleteACLs(adminClient, resource, acls); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsACLDelete DeleteACL: %s", err), "ibm_event_streams_acl", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId("")
+	log.Printf("[INFO] resourceIBMEventStreamsACLDelete deleted %d ACLs", len(acls))
+	return nil
+}
+
+// deleteACLs deletes each ACL with a filter matching exactly the ACL, as
+// DeleteACL deletes all the ACLs matching the filter.
+func deleteACLs(adminClient sarama.ClusterAdmin, resource sarama.Resource, acls []*sarama.Acl) error {
+	for _, acl := range acls {
+		_, err := adminClient.DeleteACL(sarama.AclFilter{
+			ResourceType:              resource.ResourceType,
+			ResourceName:              &resource.ResourceName,
+			ResourcePatternTypeFilter: resource.ResourcePatternType,
+			Principal:                 &acl.Principal,
+			Host:                      &acl.Host,
+			Operation:                 acl.Operation,
+			PermissionType:            acl.PermissionType,
+		}, false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func expandACLResource(d *schema.ResourceData) (sarama.Resource, error) {
+	resource := sarama.Resource{ResourceName: d.Get("resource_name").(string)}
+	if err := resource.ResourceType.UnmarshalText([]byte(aclEnumText(d.Get("resource_type").(string)))); err != nil {
+		return resource, err
+	}
+	if err := resource.ResourcePatternType.UnmarshalText([]byte(aclEnumText(d.Get("pattern_type").(string)))); err != nil {
+		return resource, err
+	}
+	return resource, nil
+}
+
+func expandACLs(l []interface{}) ([]*sarama.Acl, error) {
+	acls := make([]*sarama.Acl, 0, len(l))
+	for _, v := range l {
+		m := v.(map[string]interface{})
+		acl := &sarama.Acl{
+			Principal: m["principal"].(string),
+			Host:      m["host"].(string),
+		}
+		if err := acl.Operation.UnmarshalText([]byte(aclEnumText(m["operation"].(string)))); err != nil {
+			return nil, err
+		}
+		if err := acl.PermissionType.UnmarshalText([]byte(aclEnumText(m["permission_type"].(string)))); err != nil {
+			return nil, err
+		}
+		acls = append(acls, acl)
+	}
+	return acls, nil
+}
+
+func flattenACL(acl *sarama.Acl) map[string]interface{} {
+	return map[string]interface{}{
+		"principal":       acl.Principal,
+		"host":            acl.Host,
+		"operation":       aclEnumName(acl.Operation.String()),
+		"permission_type": aclEnumName(acl.PermissionType.String()),
+	}
+}
+
+// aclEnumText converts a schema value such as describe_configs to the text
+// of the sarama enum, DescribeConfigs, which is matched case insensitively.
+func aclEnumText(name string) string {
+	return strings.ReplaceAll(name, "_", "")
+}
+
+// aclEnumName converts the text of a sarama enum such as DescribeConfigs or
+// TransactionalID to the schema value, describe_configs or transactional_id.
+func aclEnumName(text string) string {
+	var b strings.Builder
+	runes := []rune(text)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[i-1]) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func getACLID(instanceCRN string, resourceType string, patternType string, resourceName string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "acl"
+	crnSegments[9] = strings.Join([]string{resourceType, patternType, resourceName}, "/")
+	return strings.Join(crnSegments, ":")
+}
+
+// parseACLID returns the instance CRN, resource type, pattern type and resource name of an ACL ID.
+// The resource name may contain colons and slashes.
+func parseACLID(id string) (string, string, string, string, error) {
+	crnSegments := strings.SplitN(id, ":", 10)
+	if len(crnSegments) != 10 || crnSegments[8] != "acl" {
+		return "", "", "", "", fmt.Errorf("ID '%s' is not an ACL resource", id)
+	}
+	parts := strings.SplitN(crnSegments[9], "/", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return "", "", "", "", fmt.Errorf("ID '%s' is not an ACL resource", id)
+	}
+	crnSegments[8] = ""
+	crnSegments[9] = ""
+	return strings.Join(crnSegments, ":"), parts[0], parts[1], parts[2], nil
+}
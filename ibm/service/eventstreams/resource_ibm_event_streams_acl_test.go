@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMEventStreamsACLResource(t *testing.T) {
+	topicName := fmt.Sprintf("es_topic_%d", acctest.RandInt())
+	serviceIDName := fmt.Sprintf("es-acl-%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsACLResourceConfig(getTestInstanceName(mzrKey), topicName, serviceIDName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEventStreamsACLResourceID("ibm_event_streams_acl.es_acl", "topic/literal/"+topicName),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "resource_type", "topic"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "resource_name", topicName),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "pattern_type", "literal"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "acl.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("ibm_event_streams_acl.es_acl", "acl.*", map[string]string{
+						"host":            "*",
+						"operation":       "read",
+						"permission_type": "allow",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsACLResourceConfig(getTestInstanceName(mzrKey), topicName, serviceIDName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "acl.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("ibm_event_streams_acl.es_acl", "acl.*", map[string]string{
+						"operation":       "describe_configs",
+						"permission_type": "allow",
+					}),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_acl.es_acl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsACLResourceConfig(instanceName string, topicName string, serviceIDName string, describeConfigs bool) string {
+	describeConfigsACL := ""
+	if describeConfigs {
+		describeConfigsACL = `
+			acl {
+				principal = "User:${ibm_iam_service_id.es_acl.iam_id}"
+				operation = "describe_configs"
+			}`
+	}
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "group" {
+			is_default = true
+		}
+		data "ibm_resource_instance" "es_instance" {
+			resource_group_id = data.ibm_resource_group.group.id
+			name              = "%s"
+		}
+		resource "ibm_event_streams_topic" "es_topic" {
+			resource_instance_id = data.ibm_resource_instance.es_instance.id
+			name                 = "%s"
+			partitions           = 1
+		}
+		resource "ibm_iam_service_id" "es_acl" {
+			name = "%s"
+		}
+		resource "ibm_event_streams_acl" "es_acl" {
+			resource_instance_id = data.ibm_resource_instance.es_instance.id
+			resource_type        = "topic"
+			resource_name        = ibm_event_streams_topic.es_topic.name
+			acl {
+				principal = "User:${ibm_iam_service_id.es_acl.iam_id}"
+				operation = "read"
+			}%s
+		}`, instanceName, topicName, serviceIDName, describeConfigsACL)
+}
+
+func testAccCheckIBMEventStreamsACLResourceID(name string, suffix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		aclID := rs.Primary.ID
+		if !strings.HasSuffix(aclID, ":acl:"+suffix) {
+			return fmt.Errorf("[ERROR] ACL ID %s not expected CRN", aclID)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The committed offsets of a consumer group in an Event Streams service instance.
+// The ID is the CRN with the last two components "consumergroup:group".
+// The offsets are reset when the resource is created and when the topics
+// change; they are left as is when the resource is deleted.
+func ResourceIBMEventStreamsConsumerGroupOffsets() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsConsumerGroupOffsetsCreate,
+		ReadContext:   resourceIBMEventStreamsConsumerGroupOffsetsRead,
+		UpdateContext: resourceIBMEventStreamsConsumerGroupOffsetsUpdate,
+		DeleteContext: resourceIBMEventStreamsConsumerGroupOffsetsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The CRN of the Event Streams instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the consumer group",
+			},
+			"topic": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The topics to reset the offsets of",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the topic",
+						},
+						"partitions": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The partitions to reset the offsets of, all the partitions by default",
+						},
+						"reset_to": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"earliest", "latest", "timestamp"}, false),
+							Description:  "Reset the offsets to the earliest or latest offset, or to the first offset after the timestamp",
+						},
+						"timestamp": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							Description:  "The time to reset the offsets to in RFC 3339 format, when reset_to is timestamp",
+						},
+					},
+				},
+			},
+			"offsets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The committed offsets of the consumer group for the topics",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the topic",
+						},
+						"partition": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The partition",
+						},
+						"offset": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The committed offset",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsConsumerGroupOffsetsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, err := resetConsumerGroupOffsets(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsConsumerGroupOffsetsCreate: %s", err), "ibm_event_streams_consumer_group_offsets", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId(getConsumerGroupID(instanceCRN, d.Get("group").(string)))
+	return resourceIBMEventStreamsConsumerGroupOffsetsRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsConsumerGroupOffsetsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsConsumerGroupOffsetsRead createSaramaAdminClient: %s", err), "ibm_event_streams_consumer_group_offsets", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer adminClient.Release()
+	group := d.Get("group").(string)
+	topics := []string{}
+	for _, t := range d.Get("topic").([]interface{}) {
+		topics = append(topics, t.(map[string]interface{})["name"].(string))
+	}
+	offsets, err := listConsumerGroupOffsets(adminClient, group)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsConsumerGroupOffsetsRead ListConsumerGroupOffsets: %s", err), "ibm_event_streams_consumer_group_offsets", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	flattened := []map[string]interface{}{}
+	for _, offset := range offsets {
+		if slices.Contains(topics, offset.topic) {
+			flattened = append(flattened, map[string]interface{}{
+				"topic":     offset.topic,
+				"partition": int(offset.partition),
+				"offset":    int(offset.offset),
+			})
+		}
+	}
+	d.Set("offsets", flattened)
+	return nil
+}
+
+func resourceIBMEventStreamsConsumerGroupOffsetsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("topic") {
+		if _, err := resetConsumerGroupOffsets(d, meta); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsConsumerGroupOffsetsUpdate: %s", err), "ibm_event_streams_consumer_group_offsets", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	return resourceIBMEventStreamsConsumerGroupOffsetsRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsConsumerGroupOffsetsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] resourceIBMEventStreamsConsumerGroupOffsetsDelete the offsets of consumer group %s are left as is", d.Get("group").(string))
+	d.SetId("")
+	return nil
+}
+
+// resetConsumerGroupOffsets commits the offsets of the configured topics for
+// the consumer group. The group must not have any active members, since
+// Kafka rejects offset commits from outside of an active group.
+func resetConsumerGroupOffsets(d *schema.ResourceData, meta interface{}) (string, error) {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		return "", fmt.Errorf("createSaramaAdminClient: %s", err)
+	}
+	defer adminClient.Release()
+	group := d.Get("group").(string)
+	groups, err := adminClient.DescribeConsumerGroups([]string{group})
+	if err != nil {
+		return "", fmt.Errorf("DescribeConsumerGroups %s: %s", group, err)
+	}
+	for _, g := range groups {
+		if g.State != "Empty" && g.State != "Dead" {
+			return "", fmt.Errorf("consumer group %s is %s with %d member(s), the consumers must be stopped before resetting the offsets", group, g.State, len(g.Members))
+		}
+	}
+
+	client := adminClient.Client()
+
+	request := &sarama.OffsetCommitRequest{
+		Version:                 2,
+		ConsumerGroup:           group,
+		ConsumerGroupGeneration: -1,
+		RetentionTime:           -1,
+	}
+	commits := 0
+	for _, t := range d.Get("topic").([]interface{}) {
+		topic := t.(map[string]interface{})
+		name := topic["name"].(string)
+		partitions := []int32{}
+		for _, p := range topic["partitions"].([]interface{}) {
+			partitions = append(partitions, int32(p.(int)))
+		}
+		if len(partitions) == 0 {
+			if partitions, err = client.Partitions(name); err != nil {
+				return "", fmt.Errorf("Partitions %s: %s", name, err)
+			}
+		}
+		offsetTime, err := consumerGroupOffsetTime(topic["reset_to"].(string), topic["timestamp"].(string))
+		if err != nil {
+			return "", fmt.Errorf("topic %s: %s", name, err)
+		}
+		for _, partition := range partitions {
+			offset, err := client.GetOffset(name, partition, offsetTime)
+			if err != nil {
+				return "", fmt.Errorf("GetOffset %s/%d: %s", name, partition, err)
+			}
+			// No message was produced after the timestamp, the consumers
+			// start from the end of the partition.
+			if offset < 0 && offsetTime >= 0 {
+				if offset, err = client.GetOffset(name, partition, sarama.OffsetNewest); err != nil {
+					return "", fmt.Errorf("GetOffset %s/%d: %s", name, partition, err)
+				}
+			}
+			log.Printf("[DEBUG] resetConsumerGroupOffsets %s %s/%d to %d", group, name, partition, offset)
+			request.AddBlock(name, partition, offset, 0, "")
+			commits++
+		}
+	}
+
+	coordinator, err := client.Coordinator(group)
+	if err != nil {
+		return "", fmt.Errorf("Coordinator %s: %s", group, err)
+	}
+	response, err := coordinator.CommitOffset(request)
+	if err != nil {
+		return "", fmt.Errorf("CommitOffset %s: %s", group, err)
+	}
+	var errs []string
+	for topic, partitions := range response.Errors {
+		for partition, kerr := range partitions {
+			if kerr != sarama.ErrNoError {
+				errs = append(errs, fmt.Sprintf("%s/%d: %s", topic, partition, kerr))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return "", fmt.Errorf("CommitOffset %s failed for:\n%s", group, strings.Join(errs, "\n"))
+	}
+	log.Printf("[INFO] resetConsumerGroupOffsets reset %d offsets of consumer group %s", commits, group)
+	return instanceCRN, nil
+}
+
+// consumerGroupOffsetTime returns the time argument of an offset request:
+// sarama.OffsetOldest, sarama.OffsetNewest or a time in milliseconds.
+func consumerGroupOffsetTime(resetTo string, timestamp string) (int64, error) {
+	switch resetTo {
+	case "earliest":
+		return sarama.OffsetOldest, nil
+	case "latest":
+		return sarama.OffsetNewest, nil
+	}
+	if timestamp == "" {
+		return 0, fmt.Errorf("timestamp is required when reset_to is timestamp")
+	}
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixMilli(), nil
+}
+
+type consumerGroupOffset struct {
+	topic     string
+	partition int32
+	offset    int64
+}
+
+// listConsumerGroupOffsets returns the committed offsets of all the
+// partitions of a consumer group, sorted by topic and partition.
+func listConsumerGroupOffsets(adminClient sarama.ClusterAdmin, group string) ([]consumerGroupOffset, error) {
+	response, err := adminClient.ListConsumerGroupOffsets(group, nil)
+	if err != nil {
+		return nil, err
+	}
+	if response.Err != sarama.ErrNoError {
+		return nil, response.Err
+	}
+	offsets := []consumerGroupOffset{}
+	for topic, partitions := range response.Blocks {
+		for partition, block := range partitions {
+			if block.Err != sarama.ErrNoError || block.Offset < 0 {
+				continue
+			}
+			offsets = append(offsets, consumerGroupOffset{topic: topic, partition: partition, offset: block.Offset})
+		}
+	}
+	sort.Slice(offsets, func(i, j int) bool {
+		if offsets[i].topic != offsets[j].topic {
+			return offsets[i].topic < offsets[j].topic
+		}
+		return offsets[i].partition < offsets[j].partition
+	})
+	return offsets, nil
+}
+
+func getConsumerGroupID(instanceCRN string, group string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "consumergroup"
+	crnSegments[9] = group
+	return strings.Join(crnSegments, ":")
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsConsumerGroupOffsetsResource(t *testing.T) {
+	topicName := fmt.Sprintf("es_topic_%d", acctest.RandInt())
+	group := fmt.Sprintf("es_group_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsConsumerGroupOffsetsResourceConfig(getTestInstanceName(mzrKey), topicName, group, `reset_to = "earliest"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("ibm_event_streams_consumer_group_offsets.es_offsets", "id", regexp.MustCompile(":consumergroup:"+group+"$")),
+					resource.TestCheckResourceAttr("ibm_event_streams_consumer_group_offsets.es_offsets", "offsets.#", "2"),
+					resource.TestCheckResourceAttr("ibm_event_streams_consumer_group_offsets.es_offsets", "offsets.0.topic", topicName),
+					resource.TestCheckResourceAttr("ibm_event_streams_consumer_group_offsets.es_offsets", "offsets.0.partition", "0"),
+					resource.TestCheckResourceAttr("ibm_event_streams_consumer_group_offsets.es_offsets", "offsets.0.offset", "0"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_consumer_groups.es_groups", "consumer_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_consumer_groups.es_groups", "consumer_groups.0.name", group),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_consumer_groups.es_groups", "consumer_groups.0.state", "Empty"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_consumer_groups.es_groups", "consumer_groups.0.total_lag", "0"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_consumer_groups.es_groups", "consumer_groups.0.partitions.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsConsumerGroupOffsetsResourceConfig(getTestInstanceName(mzrKey), topicName, group, `reset_to = "timestamp"
+				timestamp = "2025-01-01T00:00:00Z"
+				partitions = [1]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_consumer_group_offsets.es_offsets", "topic.0.reset_to", "timestamp"),
+					resource.TestCheckResourceAttr("ibm_event_streams_consumer_group_offsets.es_offsets", "offsets.#", "2"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMEventStreamsConsumerGroupOffsetsResourceConfig(getTestInstanceName(mzrKey), topicName, group, `reset_to = "timestamp"`),
+				ExpectError: regexp.MustCompile("timestamp is required"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsConsumerGroupOffsetsResourceConfig(instanceName string, topicName string, group string, resetTo string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "group" {
+			is_default = true
+		}
+		data "ibm_resource_instance" "es_instance" {
+			resource_group_id = data.ibm_resource_group.group.id
+			name              = "%s"
+		}
+		resource "ibm_event_streams_topic" "es_topic" {
+			resource_instance_id = data.ibm_resource_instance.es_instance.id
+			name                 = "%s"
+			partitions           = 2
+		}
+		resource "ibm_event_streams_consumer_group_offsets" "es_offsets" {
+			resource_instance_id = data.ibm_resource_instance.es_instance.id
+			group                = "%s"
+			topic {
+				name = ibm_event_streams_topic.es_topic.name
+				%s
+			}
+		}
+		data "ibm_event_streams_consumer_groups" "es_groups" {
+			resource_instance_id = data.ibm_resource_instance.es_instance.id
+			names                = [ibm_event_streams_consumer_group_offsets.es_offsets.group]
+		}`, instanceName, topicName, group, resetTo)
+}
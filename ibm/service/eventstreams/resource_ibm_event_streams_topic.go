@@ -9,6 +9,7 @@ import (
 	"log"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM-Cloud/bluemix-go/session"
@@ -48,7 +49,25 @@ func ResourceIBMEventStreamsTopic() *schema.Resource {
 		ReadContext:   resourceIBMEventStreamsTopicRead,
 		UpdateContext: resourceIBMEventStreamsTopicUpdate,
 		DeleteContext: resourceIBMEventStreamsTopicDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMEventStreamsTopicImport,
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"resource_instance_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The CRN of the Event Streams instance",
+					},
+					"name": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The name of the topic",
+					},
+				}
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"resource_instance_id": {
 				Type:        schema.TypeString,
@@ -86,10 +105,6 @@ func ResourceIBMEventStreamsTopic() *schema.Resource {
 	}
 }
 
-// clientPool maintains Kafka admin client for each instance.
-// key is instance's CRN
-var clientPool = map[string]sarama.ClusterAdmin{}
-
 func resourceIBMEventStreamsTopicExists(context context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
 	log.Printf("[DEBUG] resourceIBMEventStreamsTopicExists")
 	adminClient, _, err := createSaramaAdminClient(d, meta)
@@ -97,6 +112,7 @@ func resourceIBMEventStreamsTopicExists(context context.Context, d *schema.Resou
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicExists createSaramaAdminClient err %s", err)
 		return false, err
 	}
+	defer adminClient.Release()
 	topicName := d.Get("name").(string)
 	topicsMetadata, err := adminClient.DescribeTopics([]string{topicName})
 	if err != nil {
@@ -126,6 +142,7 @@ func resourceIBMEventStreamsTopicCreate(context context.Context, d *schema.Resou
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	defer adminClient.Release()
 	topicName := d.Get("name").(string)
 	partitions := d.Get("partitions").(int)
 	config := d.Get("config").(map[string]interface{})
@@ -167,6 +184,7 @@ func resourceIBMEventStreamsTopicRead(context context.Context, d *schema.Resourc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	defer adminClient.Release()
 	topicID := d.Id()
 	topicName := getTopicName(topicID)
 	topics, err := adminClient.ListTopics()
@@ -189,6 +207,15 @@ func resourceIBMEventStreamsTopicRead(context context.Context, d *schema.Resourc
 				}
 				d.Set("config", topicDetail2Config(savedConfig))
 			}
+			err = flex.SetResourceIdentity(d, map[string]interface{}{
+				"resource_instance_id": instanceCRN,
+				"name":                 name,
+			})
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsTopicRead SetResourceIdentity: %s", err), "ibm_event_streams_topic", "read")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
 			return nil
 		}
 	}
@@ -205,6 +232,7 @@ func resourceIBMEventStreamsTopicUpdate(context context.Context, d *schema.Resou
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	defer adminClient.Release()
 	topicName := d.Get("name").(string)
 	if d.HasChange("partitions") {
 		oi, ni := d.GetChange("partitions")
@@ -243,6 +271,7 @@ func resourceIBMEventStreamsTopicDelete(context context.Context, d *schema.Resou
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	defer adminClient.Release()
 	topicName := d.Get("name").(string)
 	err = adminClient.DeleteTopic(topicName)
 	if err != nil {
@@ -262,24 +291,53 @@ func resourceIBMEventStreamsTopicDelete(context context.Context, d *schema.Resou
 	return nil
 }
 
-func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.ClusterAdmin, string, error) {
-	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+// createSaramaAdminClient returns the pooled Kafka admin client of the
+// instance. The caller releases the client once it is done with it.
+func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (*kafkaAdminClient, string, error) {
+	instanceCRN, adminURL, brokerAddress, err := getKafkaInstance(d, meta)
 	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient BluemixSession err %s", err)
 		return nil, "", err
 	}
+	adminClient, err := clientPool.Get(instanceCRN, func() (sarama.Client, sarama.ClusterAdmin, error) {
+		config, err := newSaramaConfig(instanceCRN, adminURL, meta)
+		if err != nil {
+			return nil, nil, err
+		}
+		client, err := sarama.NewClient(brokerAddress, config)
+		if err != nil {
+			log.Printf("[DEBUG] createSaramaAdminClient NewClient err %s", err)
+			return nil, nil, err
+		}
+		admin, err := sarama.NewClusterAdminFromClient(client)
+		if err != nil {
+			log.Printf("[DEBUG] createSaramaAdminClient NewClusterAdmin err %s", err)
+			client.Close()
+			return nil, nil, err
+		}
+		return client, admin, nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return adminClient, instanceCRN, nil
+}
+
+// getKafkaInstance returns the CRN, the admin URL and the broker addresses
+// of the Event Streams instance, and sets kafka_http_url and
+// kafka_brokers_sasl.
+func getKafkaInstance(d *schema.ResourceData, meta interface{}) (string, string, []string, error) {
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if len(instanceCRN) == 0 {
 		topicID := d.Id()
 		if len(topicID) == 0 || !strings.Contains(topicID, ":") {
 			log.Printf("[DEBUG] createSaramaAdminClient resource_instance_id is missing")
-			return nil, "", fmt.Errorf("resource_instance_id is required")
+			return "", "", nil, fmt.Errorf("resource_instance_id is required")
 		}
 		instanceCRN = getInstanceCRN(topicID)
 	}
 	instance, err := getInstanceDetails(instanceCRN, meta)
 	if err != nil {
-		return nil, "", err
+		return "", "", nil, err
 	}
 	adminURL := instance.Extensions["kafka_http_url"].(string)
 	d.Set("kafka_http_url", adminURL)
@@ -288,11 +346,14 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	slices.Sort(brokerAddress)
 	d.Set("kafka_brokers_sasl", brokerAddress)
 	log.Printf("[INFO] createSaramaAdminClient kafka_brokers_sasl is set to %s", brokerAddress)
-	var adminClient sarama.ClusterAdmin
-	var ok bool
-	if adminClient, ok = clientPool[instanceCRN]; ok {
-		log.Printf("[DEBUG] createSaramaAdminClient got client from pool for instance %s", instanceCRN)
-		return adminClient, instanceCRN, nil
+	return instanceCRN, adminURL, brokerAddress, nil
+}
+
+func newSaramaConfig(instanceCRN string, adminURL string, meta interface{}) (*sarama.Config, error) {
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		log.Printf("[DEBUG] createSaramaAdminClient BluemixSession err %s", err)
+		return nil, err
 	}
 	config := sarama.NewConfig()
 	config.ClientID = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
@@ -309,16 +370,9 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	config.Net.SASL.Mechanism = sarama.SASLTypeOAuth
 	config.Net.SASL.TokenProvider, err = newAccessTokenProvider(bxSession)
 	if err != nil {
-		return nil, "", err
-	}
-	adminClient, err = sarama.NewClusterAdmin(brokerAddress, config)
-	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient NewClusterAdmin err %s", err)
-		return nil, "", err
+		return nil, err
 	}
-	clientPool[instanceCRN] = adminClient
-	log.Printf("[INFO] createSaramaAdminClient instance %s 's client is initialized", instanceCRN)
-	return adminClient, instanceCRN, nil
+	return config, nil
 }
 
 func topicDetail2Config(topicConfigEntries map[string]*string) map[string]*string {
@@ -342,6 +396,23 @@ func config2TopicDetail(config map[string]interface{}) map[string]*string {
 	return configEntries
 }
 
+func resourceIBMEventStreamsTopicImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != "" {
+		return []*schema.ResourceData{d}, nil
+	}
+	identity, err := d.Identity()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting identity: %s", err)
+	}
+	instanceCRN := identity.Get("resource_instance_id").(string)
+	topicName := identity.Get("name").(string)
+	if len(strings.Split(instanceCRN, ":")) != 10 || topicName == "" {
+		return nil, fmt.Errorf("Expected identity to contain the CRN of the Event Streams instance and the name of the topic")
+	}
+	d.SetId(getTopicID(instanceCRN, topicName))
+	return []*schema.ResourceData{d}, nil
+}
+
 func getTopicID(instanceCRN string, topicName string) string {
 	crnSegments := strings.Split(instanceCRN, ":")
 	crnSegments[8] = "topic"
@@ -360,31 +431,57 @@ func getInstanceCRN(topicID string) string {
 	return strings.Join(crnSegments, ":")
 }
 
+// accessTokenProvider provides the IAM access tokens the Kafka clients
+// authenticate with. The pooled clients outlive a single token, and sarama
+// asks for a new token each time a broker connection authenticates or
+// re-authenticates. The authenticator refreshes the expired tokens, and it is
+// rebuilt when the session's refresh token has been renewed.
 type accessTokenProvider struct {
+	mu            sync.Mutex
+	sess          *session.Session
+	refreshToken  string
 	authenticator *core.IamAuthenticator
 }
 
 func newAccessTokenProvider(sess *session.Session) (*accessTokenProvider, error) {
-	iamEndpoint, err := sess.Config.EndpointLocator.IAMEndpoint()
+	tp := &accessTokenProvider{sess: sess}
+	if err := tp.buildAuthenticator(); err != nil {
+		return nil, err
+	}
+	return tp, nil
+}
+
+func (tp *accessTokenProvider) buildAuthenticator() error {
+	iamEndpoint, err := tp.sess.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
 		log.Printf("[DEBUG] newAccessTokenProvider.IAMEndpoint() error:%s", err)
-		return nil, err
+		return err
 	}
 	authenticator, err := core.NewIamAuthenticatorBuilder().
 		SetURL(iamEndpoint).
-		SetApiKey(sess.Config.BluemixAPIKey).
-		SetRefreshToken(sess.Config.IAMRefreshToken).
+		SetApiKey(tp.sess.Config.BluemixAPIKey).
+		SetRefreshToken(tp.sess.Config.IAMRefreshToken).
 		SetClientIDSecret("bx", "bx").
 		Build()
 	if err != nil {
 		log.Printf("[DEBUG] newAccessTokenProvider.NewIamAuthenticatorBuilder() error:%s", err)
-		return nil, err
+		return err
 	}
-	return &accessTokenProvider{authenticator}, nil
+	tp.authenticator = authenticator
+	tp.refreshToken = tp.sess.Config.IAMRefreshToken
+	return nil
 }
 
 // Token() implements sarama.AccessTokenProvider interface for sasl.mechanism=OAUTHBEARER
 func (tp *accessTokenProvider) Token() (*sarama.AccessToken, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	if tp.sess.Config.BluemixAPIKey == "" && tp.sess.Config.IAMRefreshToken != tp.refreshToken {
+		log.Printf("[DEBUG] accessTokenProvider the refresh token was renewed")
+		if err := tp.buildAuthenticator(); err != nil {
+			return nil, err
+		}
+	}
 	token, err := tp.authenticator.GetToken()
 	if err != nil {
 		log.Printf("[DEBUG] accessTokenProvider.GetToken() error:%s", err)
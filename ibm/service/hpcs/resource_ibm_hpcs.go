@@ -906,10 +906,19 @@ func hsmClient(d *schema.ResourceData, meta interface{}) (tkesdk.CommonInputs, e
 		serviceEndpoint = e.(string)
 	}
 	ci.Region = d.Get("location").(string)
-	ci.ApiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_HPCS_TKE_ENDPOINT"}, "cloud.ibm.com")
+	visibility := bluemixSession.Config.Visibility
+	apiEndpoint := "cloud.ibm.com"
 	if bluemixSession.Config.Visibility == "private" || bluemixSession.Config.Visibility == "public-and-private" || serviceEndpoint == "private-only" {
-		ci.ApiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_HPCS_TKE_ENDPOINT"}, "private.cloud.ibm.com")
+		apiEndpoint = "private.cloud.ibm.com"
 	}
+	if serviceEndpoint == "private-only" {
+		visibility = "private"
+	}
+	apiEndpoint, err = conns.FileFallBack(bluemixSession.Config.EndpointsFile, visibility, "IBMCLOUD_HPCS_TKE_ENDPOINT", ci.Region, apiEndpoint)
+	if err != nil {
+		return ci, err
+	}
+	ci.ApiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_HPCS_TKE_ENDPOINT"}, apiEndpoint)
 
 	ci.AuthToken = bluemixSession.Config.IAMAccessToken
 
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &iamAuthTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &iamAuthTokenEphemeralResource{}
+)
+
+func NewIAMAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &iamAuthTokenEphemeralResource{}
+}
+
+type iamAuthTokenEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type iamAuthTokenModel struct {
+	IAMAccessToken  types.String `tfsdk:"iam_access_token"`
+	IAMRefreshToken types.String `tfsdk:"iam_refresh_token"`
+	UAAAccessToken  types.String `tfsdk:"uaa_access_token"`
+	UAARefreshToken types.String `tfsdk:"uaa_refresh_token"`
+}
+
+func (r *iamAuthTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_iam_auth_token"
+}
+
+func (r *iamAuthTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides the IAM and UAA tokens of the provider's session without persisting them to the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"iam_access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM access token.",
+			},
+			"iam_refresh_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM refresh token.",
+			},
+			"uaa_access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The UAA access token.",
+			},
+			"uaa_refresh_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The UAA refresh token.",
+			},
+		},
+	}
+}
+
+func (r *iamAuthTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.session = session
+}
+
+func (r *iamAuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.session == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Provider Session",
+			"The ibm_iam_auth_token ephemeral resource was opened before the provider was configured.",
+		)
+		return
+	}
+
+	bmxSess, err := r.session.BluemixSession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read IAM Auth Token",
+			"An unexpected error occurred when reading the IBM Cloud session. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"IBM Cloud Session Error: "+err.Error(),
+		)
+		return
+	}
+
+	data := iamAuthTokenModel{
+		IAMAccessToken:  types.StringValue(bmxSess.Config.IAMAccessToken),
+		IAMRefreshToken: types.StringValue(bmxSess.Config.IAMRefreshToken),
+		UAAAccessToken:  types.StringValue(bmxSess.Config.UAAAccessToken),
+		UAARefreshToken: types.StringValue(bmxSess.Config.UAARefreshToken),
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAuthTokenEphemeralResource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAuthTokenEphemeralResourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMIAMAuthTokenNotInState(),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMIAMAuthTokenNotInState verifies that nothing of the
+// ephemeral resource was written to the state.
+func testAccCheckIBMIAMAuthTokenNotInState() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name := range s.RootModule().Resources {
+			if name == "ephemeral.ibm_iam_auth_token.token" {
+				return fmt.Errorf("Ephemeral resource %s found in state", name)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMAuthTokenEphemeralResourceConfig() string {
+	return `
+	ephemeral "ibm_iam_auth_token" "token" {
+	}
+`
+}
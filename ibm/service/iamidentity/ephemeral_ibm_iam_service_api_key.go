@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &iamServiceAPIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &iamServiceAPIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &iamServiceAPIKeyEphemeralResource{}
+)
+
+// iamServiceAPIKeyPrivateKey is the private data key holding the ID of the
+// API key created in Open, so that Close can delete it again.
+const iamServiceAPIKeyPrivateKey = "apikey_id"
+
+func NewIAMServiceAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &iamServiceAPIKeyEphemeralResource{}
+}
+
+type iamServiceAPIKeyEphemeralResource struct {
+	client  *iamidentityv1.IamIdentityV1
+	session conns.ClientSession
+}
+
+type iamServiceAPIKeyModel struct {
+	Name         types.String `tfsdk:"name"`
+	IAMServiceID types.String `tfsdk:"iam_service_id"`
+	Description  types.String `tfsdk:"description"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+	ID           types.String `tfsdk:"id"`
+	AccountID    types.String `tfsdk:"account_id"`
+	CRN          types.String `tfsdk:"crn"`
+	Apikey       types.String `tfsdk:"apikey"`
+}
+
+func (r *iamServiceAPIKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_iam_service_api_key"
+}
+
+func (r *iamServiceAPIKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived API key for a service ID. The API key value is never persisted to the Terraform plan or state, and the API key is deleted when Terraform closes the ephemeral resource.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the Service API key",
+			},
+			"iam_service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The service iam_id that this API key authenticates",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the API key",
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "Date and time when the API key becomes invalid, ISO 8601 datetime in the format 'yyyy-MM-ddTHH:mm+0000'. Acts as a safeguard in case Terraform is interrupted before the API key is deleted.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the API key",
+			},
+			"account_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The account ID of the API key",
+			},
+			"crn": schema.StringAttribute{
+				Computed:    true,
+				Description: "crn of the Service API Key",
+			},
+			"apikey": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "API key value for this API key",
+			},
+		},
+	}
+}
+
+func (r *iamServiceAPIKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := session.IAMIdentityV1API()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create IAM Identity Client",
+			"An unexpected error occurred when creating the IAM Identity client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"IAM Identity Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	r.client = client
+	r.session = session
+}
+
+func (r *iamServiceAPIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data iamServiceAPIKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured IAM Identity Client",
+			"The ibm_iam_service_api_key ephemeral resource was opened before the provider was configured.",
+		)
+		return
+	}
+
+	userDetails, err := r.session.BluemixUserDetails()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Account Details",
+			"An unexpected error occurred when reading the account of the IBM Cloud session.\n\n"+
+				"IBM Cloud Session Error: "+err.Error(),
+		)
+		return
+	}
+
+	name := data.Name.ValueString()
+	iamID := data.IAMServiceID.ValueString()
+	createAPIKeyOptions := &iamidentityv1.CreateAPIKeyOptions{
+		Name:      &name,
+		IamID:     &iamID,
+		AccountID: &userDetails.UserAccount,
+		// The value is only handed out once, it must not be retrievable later on.
+		StoreValue: new(bool),
+	}
+	if !data.Description.IsNull() {
+		createAPIKeyOptions.Description = data.Description.ValueStringPointer()
+	}
+	if !data.ExpiresAt.IsNull() {
+		createAPIKeyOptions.ExpiresAt = data.ExpiresAt.ValueStringPointer()
+	}
+
+	apiKey, response, err := r.client.CreateAPIKeyWithContext(ctx, createAPIKeyOptions)
+	if err != nil || apiKey == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Service API Key",
+			fmt.Sprintf("Service API Key creation Error: %s\n%s", err, response),
+		)
+		return
+	}
+
+	privateData, err := json.Marshal(*apiKey.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Store Service API Key ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, iamServiceAPIKeyPrivateKey, privateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(apiKey.ID)
+	data.AccountID = types.StringPointerValue(apiKey.AccountID)
+	data.CRN = types.StringPointerValue(apiKey.CRN)
+	data.Apikey = types.StringPointerValue(apiKey.Apikey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *iamServiceAPIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateData, diags := req.Private.GetKey(ctx, iamServiceAPIKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	var apiKeyID string
+	if err := json.Unmarshal(privateData, &apiKeyID); err != nil {
+		resp.Diagnostics.AddError("Unable to Read Service API Key ID", err.Error())
+		return
+	}
+
+	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
+		ID: &apiKeyID,
+	}
+	response, err := r.client.DeleteAPIKeyWithContext(ctx, deleteAPIKeyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[DEBUG] Service API Key %s was already deleted", apiKeyID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Delete Service API Key",
+			fmt.Sprintf("Error deleting Service API Key %s: %s\n%s", apiKeyID, err, response),
+		)
+	}
+}
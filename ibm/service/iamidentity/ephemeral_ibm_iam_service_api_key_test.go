@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMServiceAPIKeyEphemeralResource_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("terraform_iam_ephemeral_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyEphemeralResourceConfig(serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_iam_service_id.serviceID", "iam_id"),
+					testAccCheckIBMIAMServiceAPIKeyEphemeralResourceClosed("ibm_iam_service_id.serviceID", name),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMIAMServiceAPIKeyEphemeralResourceClosed verifies that the
+// API key created when the ephemeral resource was opened was deleted again
+// when it was closed.
+func testAccCheckIBMIAMServiceAPIKeyEphemeralResourceClosed(serviceID, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[serviceID]
+		if !ok {
+			return fmt.Errorf("Not found: %s", serviceID)
+		}
+
+		iamIdentityClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return err
+		}
+		userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+
+		iamID := rs.Primary.Attributes["iam_id"]
+		listAPIKeysOptions := &iamidentityv1.ListAPIKeysOptions{
+			AccountID: &userDetails.UserAccount,
+			IamID:     &iamID,
+		}
+
+		apiKeys, response, err := iamIdentityClient.ListAPIKeys(listAPIKeysOptions)
+		if err != nil {
+			return fmt.Errorf("Error listing Service API Keys: %s\n%s", err, response)
+		}
+		for _, apiKey := range apiKeys.Apikeys {
+			if apiKey.Name != nil && *apiKey.Name == name {
+				return fmt.Errorf("Service API Key %s still exists after the ephemeral resource was closed", *apiKey.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMServiceAPIKeyEphemeralResourceConfig(serviceName, name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+
+		ephemeral "ibm_iam_service_api_key" "testacc_apiKey" {
+			name           = "%s"
+			iam_service_id = ibm_iam_service_id.serviceID.iam_id
+			description    = "Ephemeral Service API Key"
+		}
+	`, serviceName, name)
+}
@@ -26,6 +26,9 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 		Exists: resourceIBMIAMAccessGroupPolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := flex.SetIDFromIdentity(d, "/", "access_group_id", "policy_id"); err != nil {
+					return nil, err
+				}
 				resources, resourceAttributes, err := importAccessGroupPolicy(d, meta)
 				if err != nil {
 					return nil, fmt.Errorf("[ERROR] Error reading resource ID: %s", err)
@@ -35,6 +38,22 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"access_group_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the access group.",
+					},
+					"policy_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the policy.",
+					},
+				}
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -528,7 +547,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
 
-	return nil
+	return flex.SetResourceIdentityFromID(d, "/", "access_group_id", "policy_id")
 }
 
 func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -20,12 +20,25 @@ import (
 
 func ResourceIBMIAMAuthorizationPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMAuthorizationPolicyCreate,
-		Read:     resourceIBMIAMAuthorizationPolicyRead,
-		Update:   resourceIBMIAMAuthorizationPolicyUpdate,
-		Delete:   resourceIBMIAMAuthorizationPolicyDelete,
-		Exists:   resourceIBMIAMAuthorizationPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMIAMAuthorizationPolicyCreate,
+		Read:   resourceIBMIAMAuthorizationPolicyRead,
+		Update: resourceIBMIAMAuthorizationPolicyUpdate,
+		Delete: resourceIBMIAMAuthorizationPolicyDelete,
+		Exists: resourceIBMIAMAuthorizationPolicyExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the authorization policy.",
+					},
+				}
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"source_service_name": {
@@ -544,7 +557,7 @@ func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interfac
 	d.Set("source_service_account", flex.GetV2PolicySubjectAttribute("accountId", *source))
 	d.Set("source_resource_group_id", flex.GetV2PolicySubjectAttribute("resourceGroupId", *source))
 
-	return nil
+	return flex.SetResourceIdentityFromID(d, "", "id")
 }
 
 // Returns nil, because ibmcloud iam cli authorization policy does not have an update command
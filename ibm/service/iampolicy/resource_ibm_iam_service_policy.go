@@ -27,6 +27,9 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 		Exists: resourceIBMIAMServicePolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := flex.SetIDFromIdentity(d, "/", "service_or_iam_id", "policy_id"); err != nil {
+					return nil, err
+				}
 				resources, resourceAttributes, err := importServicePolicy(d, meta)
 				if err != nil {
 					return nil, fmt.Errorf("[ERROR] Error reading resource ID: %s", err)
@@ -36,6 +39,22 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"service_or_iam_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the service ID, or its IAM ID if the policy was created with iam_id.",
+					},
+					"policy_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the policy.",
+					},
+				}
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"iam_service_id": {
 				Type:        schema.TypeString,
@@ -563,7 +582,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
 
-	return nil
+	return flex.SetResourceIdentityFromID(d, "/", "service_or_iam_id", "policy_id")
 }
 
 func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -27,6 +27,9 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 		Exists: resourceIBMIAMTrustedProfilePolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := flex.SetIDFromIdentity(d, "/", "profile_id", "policy_id"); err != nil {
+					return nil, err
+				}
 				resources, resourceAttributes, err := importTrustedProfilePolicy(d, meta)
 				if err != nil {
 					return nil, fmt.Errorf("[ERROR] Error reading resource ID: %s", err)
@@ -36,6 +39,22 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"profile_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the trusted profile, or its IAM ID.",
+					},
+					"policy_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the policy.",
+					},
+				}
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
@@ -559,7 +578,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
 
-	return nil
+	return flex.SetResourceIdentityFromID(d, "/", "profile_id", "policy_id")
 }
 
 func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
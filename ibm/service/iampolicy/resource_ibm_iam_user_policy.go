@@ -25,6 +25,9 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 		Exists: resourceIBMIAMUserPolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := flex.SetIDFromIdentity(d, "/", "ibm_id", "policy_id"); err != nil {
+					return nil, err
+				}
 				resources, resourceAttributes, err := importUserPolicy(d, meta)
 				if err != nil {
 					return nil, fmt.Errorf("[ERROR] Error reading resource ID: %s", err)
@@ -34,6 +37,22 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"ibm_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The IBM ID or email address of the user.",
+					},
+					"policy_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the policy.",
+					},
+				}
+			},
+		},
 		Schema: map[string]*schema.Schema{
 
 			"ibm_id": {
@@ -509,7 +528,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
 
-	return nil
+	return flex.SetResourceIdentityFromID(d, "/", "ibm_id", "policy_id")
 }
 
 func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
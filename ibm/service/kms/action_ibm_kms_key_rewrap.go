@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = &kmsKeyRewrapAction{}
+	_ action.ActionWithConfigure      = &kmsKeyRewrapAction{}
+	_ action.ActionWithValidateConfig = &kmsKeyRewrapAction{}
+)
+
+func NewKMSKeyRewrapAction() action.Action {
+	return &kmsKeyRewrapAction{}
+}
+
+type kmsKeyRewrapAction struct {
+	session conns.ClientSession
+}
+
+type kmsKeyRewrapModel struct {
+	InstanceID         types.String `tfsdk:"instance_id"`
+	KeyID              types.String `tfsdk:"key_id"`
+	EndpointType       types.String `tfsdk:"endpoint_type"`
+	Ciphertexts        types.List   `tfsdk:"ciphertexts"`
+	AdditionalAuthData types.List   `tfsdk:"additional_auth_data"`
+}
+
+func (a *kmsKeyRewrapAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_kms_key_rewrap"
+}
+
+func (a *kmsKeyRewrapAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rewraps data encryption keys (DEKs) with the latest version of a Key Protect or Hyper Protect Crypto Services root key, typically after the key is rotated with the ibm_kms_key_rotate action. The rewrapped ciphertext of each DEK is reported as the action progresses. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Key protect or hpcs instance GUID or CRN.",
+			},
+			"key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID or alias of the root key that wrapped the DEKs.",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The type of the endpoint used to rewrap the DEKs. Allowable values are: public, private. Default: public",
+			},
+			"ciphertexts": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The ciphertexts of the wrapped DEKs, as returned when they were wrapped.",
+			},
+			"additional_auth_data": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The additional authentication data (AAD) used when the DEKs were wrapped.",
+			},
+		},
+	}
+}
+
+func (a *kmsKeyRewrapAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config kmsKeyRewrapModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateKMSEndpointType(config.EndpointType)...)
+	if !config.Ciphertexts.IsNull() && !config.Ciphertexts.IsUnknown() && len(config.Ciphertexts.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ciphertexts"),
+			"Missing Ciphertexts",
+			"At least one ciphertext must be given to rewrap.",
+		)
+	}
+}
+
+func (a *kmsKeyRewrapAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.session = configureKMSAction(req, resp)
+}
+
+func (a *kmsKeyRewrapAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config kmsKeyRewrapModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kpAPI, diags := kmsActionClient(a.session, config.InstanceID, config.EndpointType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ciphertexts []string
+	resp.Diagnostics.Append(config.Ciphertexts.ElementsAs(ctx, &ciphertexts, false)...)
+	var aad *[]string
+	if !config.AdditionalAuthData.IsNull() {
+		var values []string
+		resp.Diagnostics.Append(config.AdditionalAuthData.ElementsAs(ctx, &values, false)...)
+		aad = &values
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyID := config.KeyID.ValueString()
+	rewrapped := 0
+	for i, ciphertext := range ciphertexts {
+		// Unwrapping a DEK wrapped with an earlier key version also returns
+		// it wrapped with the latest version. The plaintext is dropped.
+		_, newCiphertext, err := kpAPI.UnwrapV2(ctx, keyID, []byte(ciphertext), aad)
+		if err != nil {
+			resp.Diagnostics.Append(kmsActionError(err, fmt.Sprintf("rewrap ciphertexts[%d]", i), "ibm_kms_key_rewrap")...)
+			return
+		}
+
+		if len(newCiphertext) == 0 {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("ciphertexts[%d] is already wrapped with the latest version of key '%s'", i, keyID),
+			})
+			continue
+		}
+		rewrapped++
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("ciphertexts[%d] rewrapped with the latest version of key '%s': %s", i, keyID, newCiphertext),
+		})
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rewrapped %d of %d ciphertexts with key '%s'", rewrapped, len(ciphertexts), keyID),
+	})
+}
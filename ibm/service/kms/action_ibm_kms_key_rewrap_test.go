@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccIBMKMSKeyRewrapActionInvalidConfig verifies that an empty list of
+// ciphertexts and an unknown endpoint type are rejected when the
+// configuration is validated.
+func TestAccIBMKMSKeyRewrapActionInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					action "ibm_kms_key_rewrap" "test_action" {
+						config {
+							instance_id = "00000000-0000-0000-0000-000000000000"
+							key_id      = "00000000-0000-0000-0000-000000000000"
+							ciphertexts = []
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("Missing Ciphertexts"),
+			},
+			{
+				Config: `
+					action "ibm_kms_key_rewrap" "test_action" {
+						config {
+							instance_id   = "00000000-0000-0000-0000-000000000000"
+							key_id        = "00000000-0000-0000-0000-000000000000"
+							endpoint_type = "direct"
+							ciphertexts   = ["ciphertext"]
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Endpoint Type"),
+			},
+		},
+	})
+}
@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = &kmsKeyRotateAction{}
+	_ action.ActionWithConfigure      = &kmsKeyRotateAction{}
+	_ action.ActionWithValidateConfig = &kmsKeyRotateAction{}
+)
+
+func NewKMSKeyRotateAction() action.Action {
+	return &kmsKeyRotateAction{}
+}
+
+type kmsKeyRotateAction struct {
+	session conns.ClientSession
+}
+
+type kmsKeyRotateModel struct {
+	InstanceID     types.String `tfsdk:"instance_id"`
+	KeyID          types.String `tfsdk:"key_id"`
+	EndpointType   types.String `tfsdk:"endpoint_type"`
+	Payload        types.String `tfsdk:"payload"`
+	EncryptedNonce types.String `tfsdk:"encrypted_nonce"`
+	IVValue        types.String `tfsdk:"iv_value"`
+	WaitTimeout    types.Int64  `tfsdk:"wait_timeout"`
+}
+
+func (a *kmsKeyRotateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_kms_key_rotate"
+}
+
+func (a *kmsKeyRotateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a Key Protect or Hyper Protect Crypto Services root key on demand and waits until the new key version is available. Data encryption keys wrapped with earlier versions can be rewrapped with the ibm_kms_key_rewrap action.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Key protect or hpcs instance GUID or CRN.",
+			},
+			"key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID or alias of the root key to rotate.",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The type of the endpoint used to rotate the key. Allowable values are: public, private. Default: public",
+			},
+			"payload": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The new base64 encoded key material of an imported root key. Required to rotate an imported root key, must not be set for a root key generated by the service. Can be set from an ephemeral value.",
+			},
+			"encrypted_nonce": schema.StringAttribute{
+				Optional:    true,
+				Description: "The encrypted nonce of the import token used to encrypt the payload, for a securely imported root key.",
+			},
+			"iv_value": schema.StringAttribute{
+				Optional:    true,
+				Description: "The initialization vector used to encrypt the payload, for a securely imported root key.",
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds to wait for the new key version. If not specified, defaults to 300 seconds (5 minutes).",
+			},
+		},
+	}
+}
+
+func (a *kmsKeyRotateAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config kmsKeyRotateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateKMSEndpointType(config.EndpointType)...)
+	if config.Payload.IsNull() {
+		for attribute, value := range map[string]types.String{"encrypted_nonce": config.EncryptedNonce, "iv_value": config.IVValue} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"Missing Key Material",
+					fmt.Sprintf("%s can only be set with payload, to rotate a securely imported root key.", attribute),
+				)
+			}
+		}
+	}
+}
+
+func (a *kmsKeyRotateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.session = configureKMSAction(req, resp)
+}
+
+func (a *kmsKeyRotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config kmsKeyRotateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitTimeout := 300 * time.Second
+	if !config.WaitTimeout.IsNull() {
+		waitTimeout = time.Duration(config.WaitTimeout.ValueInt64()) * time.Second
+	}
+
+	kpAPI, diags := kmsActionClient(a.session, config.InstanceID, config.EndpointType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyID := config.KeyID.ValueString()
+	key, err := kpAPI.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		resp.Diagnostics.Append(kmsActionError(err, "retrieve the key", "ibm_kms_key_rotate")...)
+		return
+	}
+	if key.Extractable {
+		resp.Diagnostics.AddError(
+			"Invalid Key Type",
+			fmt.Sprintf("Key '%s' is a standard key, only root keys can be rotated.", keyID),
+		)
+		return
+	}
+	previousVersion := ""
+	if key.KeyVersion != nil {
+		previousVersion = key.KeyVersion.ID
+	}
+
+	var payload *kp.KeyPayload
+	if !config.Payload.IsNull() {
+		newKey := kp.NewKeyPayload(config.Payload.ValueString(), config.EncryptedNonce.ValueString(), config.IVValue.ValueString())
+		payload = &newKey
+	}
+	if err = kpAPI.RotateV2(ctx, keyID, payload); err != nil {
+		resp.Diagnostics.Append(kmsActionError(err, "rotate the key", "ibm_kms_key_rotate")...)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Requested rotation of key '%s', waiting for the new key version (timeout: %v)...", keyID, waitTimeout),
+	})
+
+	version, err := waitForKMSKeyVersion(ctx, kpAPI, keyID, previousVersion, waitTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Key Rotation Failed",
+			fmt.Sprintf("Key '%s' was not rotated: %s", keyID, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Key '%s' rotated, the current key version is '%s'", keyID, version),
+	})
+}
+
+// waitForKMSKeyVersion waits until the current version of the key is not
+// previousVersion anymore, and returns the new version.
+func waitForKMSKeyVersion(ctx context.Context, kpAPI *kp.Client, keyID, previousVersion string, timeout time.Duration) (string, error) {
+	deadline := time.Now().Add(timeout)
+	pollInterval := 2 * time.Second
+	maxInterval := 30 * time.Second
+	backoffMultiplier := 1.5
+
+	for time.Now().Before(deadline) {
+		key, err := kpAPI.GetKeyMetadata(ctx, keyID)
+		if err != nil && !isRetryableKMSActionError(err) {
+			return "", fmt.Errorf("failed to get key version: %w", err)
+		}
+		if err == nil && key.KeyVersion != nil && key.KeyVersion.ID != "" && key.KeyVersion.ID != previousVersion {
+			return key.KeyVersion.ID, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("operation cancelled: %w", ctx.Err())
+		case <-time.After(pollInterval):
+		}
+
+		pollInterval = time.Duration(float64(pollInterval) * backoffMultiplier)
+		if pollInterval > maxInterval {
+			pollInterval = maxInterval
+		}
+	}
+
+	return "", fmt.Errorf("timeout after %v waiting for a new key version", timeout)
+}
+
+// configureKMSAction returns the client session of the provider for the KMS
+// actions, which create their KP client for the instance given to them.
+func configureKMSAction(req action.ConfigureRequest, resp *action.ConfigureResponse) conns.ClientSession {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return nil
+	}
+	return session
+}
+
+func validateKMSEndpointType(endpointType types.String) (diags diag.Diagnostics) {
+	if endpointType.IsNull() || endpointType.IsUnknown() {
+		return
+	}
+	if value := endpointType.ValueString(); value != "public" && value != "private" {
+		diags.AddAttributeError(
+			path.Root("endpoint_type"),
+			"Invalid Endpoint Type",
+			fmt.Sprintf("The endpoint_type must be one of public, private, got: %s", value),
+		)
+	}
+	return
+}
+
+func kmsActionClient(session conns.ClientSession, instanceID, endpointType types.String) (*kp.Client, diag.Diagnostics) {
+	kpAPI, _, err := kpClientForInstance(session, getInstanceIDFromCRN(instanceID.ValueString()), endpointType.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Unable to Create Key Management Client",
+			"An unexpected error occurred when creating the Key Protect client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Key Protect Client Error: "+err.Error(),
+		)
+		return nil, diags
+	}
+	return kpAPI, nil
+}
+
+func isRetryableKMSActionError(err error) bool {
+	var kpError *kp.Error
+	if !errors.As(err, &kpError) {
+		return true
+	}
+
+	statusCode := kpError.StatusCode
+	return statusCode == 429 ||
+		statusCode == 500 ||
+		statusCode == 502 ||
+		statusCode == 503 ||
+		statusCode == 504
+}
+
+func kmsActionError(err error, operation, actionName string) (diags diag.Diagnostics) {
+	var kpError *kp.Error
+	if !errors.As(err, &kpError) {
+		diags.AddError(
+			"Network Error",
+			fmt.Sprintf("Failed to connect to the Key Protect API: %s", err.Error()),
+		)
+		return
+	}
+
+	switch kpError.StatusCode {
+	case 401:
+		diags.AddError(
+			"Authentication Failed",
+			fmt.Sprintf("Authentication with IBM Cloud failed. Please verify your API key or credentials are valid and not expired. Error: %s", err.Error()),
+		)
+	case 403:
+		diags.AddError(
+			"Authorization Failed",
+			fmt.Sprintf("You do not have permission to %s. Please verify you have the 'Manager' service role or higher on the instance. Error: %s", operation, err.Error()),
+		)
+	case 404:
+		diags.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("The specified key was not found. Please verify the instance_id and key_id are correct. Error: %s", err.Error()),
+		)
+	case 409:
+		diags.AddError(
+			"Conflict",
+			fmt.Sprintf("Unable to %s due to a conflict. This may occur if the key is disabled or was rotated less than an hour ago. Error: %s", operation, err.Error()),
+		)
+	default:
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to %s (HTTP %d): %s", operation, kpError.StatusCode, err.Error()), actionName, "invoke")
+		diags.Append(tfErr.GetFrameworkDiag(path.Empty())...)
+	}
+	return
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kms"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccIBMKMSKeyRotateActionBasic rotates a root key when the rotation
+// trigger changes and verifies the key has been rotated once the action returns.
+func TestAccIBMKMSKeyRotateActionBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKMSKeyRotateActionConfig(instanceName, keyName, "first rotation"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_name", keyName),
+				),
+			},
+			{
+				Config: testAccCheckIBMKMSKeyRotateActionConfig(instanceName, keyName, "second rotation"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMKMSKeyRotated("ibm_kms_key.test"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccIBMKMSKeyRotateActionInvalidConfig verifies that the import token
+// values are rejected without key material.
+func TestAccIBMKMSKeyRotateActionInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					action "ibm_kms_key_rotate" "test_action" {
+						config {
+							instance_id     = "00000000-0000-0000-0000-000000000000"
+							key_id          = "00000000-0000-0000-0000-000000000000"
+							encrypted_nonce = "nonce"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("Missing Key Material"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKMSKeyRotated(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		session := acc.TestAccProvider.Meta().(conns.ClientSession)
+		kpAPI, err := session.KeyManagementAPI()
+		if err != nil {
+			return err
+		}
+		rsConClient, err := session.ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		instanceID := rs.Primary.Attributes["instance_id"]
+		instance, _, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{ID: &instanceID})
+		if err != nil {
+			return err
+		}
+		kpAPI.URL, err = kms.KmsEndpointURL(kpAPI, "public", instance.Extensions)
+		if err != nil {
+			return err
+		}
+		kpAPI.Config.InstanceID = instanceID
+
+		key, err := kpAPI.GetKeyMetadata(context.Background(), rs.Primary.Attributes["key_id"])
+		if err != nil {
+			return err
+		}
+		if key.LastRotateDate == nil {
+			return fmt.Errorf("Key %s was not rotated", rs.Primary.Attributes["key_id"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMKMSKeyRotateActionConfig(instanceName, keyName, rotation string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+
+	action "ibm_kms_key_rotate" "test_action" {
+		config {
+			instance_id = ibm_kms_key.test.instance_id
+			key_id      = ibm_kms_key.test.key_id
+		}
+	}
+
+	resource "terraform_data" "rotation" {
+		input = "%s"
+
+		lifecycle {
+			action_trigger {
+				events  = [after_update]
+				actions = [action.ibm_kms_key_rotate.test_action]
+			}
+		}
+	}`, instanceName, keyName, rotation)
+}
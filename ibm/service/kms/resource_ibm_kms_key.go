@@ -294,17 +294,24 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 
 // Populate KP Client using info from schema
 func populateKPClient(d *schema.ResourceData, meta interface{}, instanceID string) (kpAPI *kp.Client, instanceCRN *string, err error) {
-	kpAPI, err = meta.(conns.ClientSession).KeyManagementAPI()
-	if err != nil {
-		return nil, nil, err
-	}
 	var endpointType string
 
 	if v, ok := d.GetOk("endpoint_type"); ok {
 		endpointType = v.(string)
 	}
 
-	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	return kpClientForInstance(meta.(conns.ClientSession), instanceID, endpointType)
+}
+
+// kpClientForInstance returns a KP client for the endpoint of the given Key
+// Protect or HPCS instance, and the CRN of the instance.
+func kpClientForInstance(session conns.ClientSession, instanceID, endpointType string) (kpAPI *kp.Client, instanceCRN *string, err error) {
+	kpAPI, err = session.KeyManagementAPI()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rsConClient, err := session.ResourceControllerV2API()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &containerClusterConfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &containerClusterConfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &containerClusterConfigEphemeralResource{}
+)
+
+func NewContainerClusterConfigEphemeralResource() ephemeral.EphemeralResource {
+	return &containerClusterConfigEphemeralResource{}
+}
+
+type containerClusterConfigEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type containerClusterConfigModel struct {
+	ClusterNameID    types.String `tfsdk:"cluster_name_id"`
+	ResourceGroupID  types.String `tfsdk:"resource_group_id"`
+	Admin            types.Bool   `tfsdk:"admin"`
+	EndpointType     types.String `tfsdk:"endpoint_type"`
+	Host             types.String `tfsdk:"host"`
+	CACertificate    types.String `tfsdk:"ca_certificate"`
+	Token            types.String `tfsdk:"token"`
+	TokenExpiration  types.String `tfsdk:"token_expiration"`
+	AdminCertificate types.String `tfsdk:"admin_certificate"`
+	AdminKey         types.String `tfsdk:"admin_key"`
+}
+
+func (r *containerClusterConfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_container_cluster_config"
+}
+
+func (r *containerClusterConfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides the host, CA certificate and a freshly issued token or the admin certificates to access a classic, VPC or Satellite cluster, without writing them to disk or to the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The name/id of the cluster",
+			},
+			"resource_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the resource group.",
+			},
+			"admin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true will return the admin certificates instead of a token",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The type of the server URL of the cluster. Allowable values are: private, link, vpe",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The server URL of the cluster.",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The CA certificate of the cluster.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token to access the cluster, issued when the ephemeral resource is opened.",
+			},
+			"token_expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the token expires, in RFC 3339 format, when it can be determined from the token.",
+			},
+			"admin_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The admin client certificate, when admin is set to true.",
+			},
+			"admin_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The admin client key, when admin is set to true.",
+			},
+		},
+	}
+}
+
+func (r *containerClusterConfigEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config containerClusterConfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.EndpointType.IsNull() || config.EndpointType.IsUnknown() {
+		return
+	}
+	switch endpointType := config.EndpointType.ValueString(); endpointType {
+	case "private", "link", "vpe":
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint_type"),
+			"Invalid Endpoint Type",
+			fmt.Sprintf("The endpoint_type must be one of private, link, vpe, got: %s", endpointType),
+		)
+	}
+}
+
+func (r *containerClusterConfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.session = session
+}
+
+func (r *containerClusterConfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.session == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Provider Session",
+			"The ibm_container_cluster_config ephemeral resource was opened before the provider was configured.",
+		)
+		return
+	}
+
+	var data containerClusterConfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Refresh the IAM token of the session, so that the token of the
+	// cluster is issued for a full token lifetime.
+	bmxSess, err := r.session.BluemixSession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Cluster Config",
+			"An unexpected error occurred when reading the IBM Cloud session. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"IBM Cloud Session Error: "+err.Error(),
+		)
+		return
+	}
+	if bmxSess.Config.IAMRefreshToken != "" {
+		if err := conns.RefreshToken(bmxSess); err != nil {
+			log.Printf("[WARN] Unable to refresh the IAM token, using the current token: %s", err)
+		}
+	}
+
+	csClient, err := r.session.VpcContainerAPI()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Kubernetes Service Client",
+			"An unexpected error occurred when creating the Kubernetes Service client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Kubernetes Service Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// The client only downloads the cluster config to a directory, which is
+	// removed as soon as the config has been read.
+	configDir, err := os.MkdirTemp("", "ibm-cluster-config-")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Cluster Config",
+			"Unable to create a temporary directory for the cluster config: "+err.Error(),
+		)
+		return
+	}
+	defer os.RemoveAll(configDir)
+
+	name := data.ClusterNameID.ValueString()
+	targetEnv := v2.ClusterTargetHeader{ResourceGroup: data.ResourceGroupID.ValueString()}
+	clusterKeyDetails, err := getClusterConfigDetail(csClient.Clusters(), name, configDir, data.Admin.ValueBool(), targetEnv, data.EndpointType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Cluster Config",
+			fmt.Sprintf("Error downloading the cluster config [%s]: %s", name, err),
+		)
+		return
+	}
+
+	data.Host = types.StringValue(clusterKeyDetails.Host)
+	data.CACertificate = clusterConfigValue(clusterKeyDetails.ClusterCACertificate)
+	data.Token = clusterConfigValue(clusterKeyDetails.Token)
+	data.TokenExpiration = clusterConfigValue(tokenExpiration(clusterKeyDetails.Token))
+	data.AdminCertificate = clusterConfigValue(clusterKeyDetails.Admin)
+	data.AdminKey = clusterConfigValue(clusterKeyDetails.AdminKey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// clusterConfigValue returns null for the values missing from the cluster
+// config, so that they can be passed to the kubernetes and helm providers as is.
+func clusterConfigValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// tokenExpiration returns the expiration time of a JWT token, or an empty
+// string for tokens that are not JWTs, such as OpenShift OAuth tokens.
+func tokenExpiration(token string) string {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(strings.TrimPrefix(token, "Bearer "), claims); err != nil {
+		return ""
+	}
+	expiration, err := claims.GetExpirationTime()
+	if err != nil || expiration == nil {
+		return ""
+	}
+	return expiration.Time.UTC().Format(time.RFC3339)
+}
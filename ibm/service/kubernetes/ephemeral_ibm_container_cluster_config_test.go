@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMContainerClusterConfigEphemeralResource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterConfigEphemeralResourceConfig(acc.IksClusterID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerClusterConfigNotInState(),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerClusterConfigEphemeralResourceConfig(acc.IksClusterID, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerClusterConfigNotInState(),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMContainerClusterConfigEphemeralResource_InvalidEndpointType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					ephemeral "ibm_container_cluster_config" "config" {
+						cluster_name_id = "cluster"
+						endpoint_type   = "public"
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Endpoint Type"),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMContainerClusterConfigNotInState verifies that nothing of the
+// ephemeral resource was written to the state.
+func testAccCheckIBMContainerClusterConfigNotInState() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name := range s.RootModule().Resources {
+			if name == "ephemeral.ibm_container_cluster_config.config" {
+				return fmt.Errorf("Ephemeral resource %s found in state", name)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMContainerClusterConfigEphemeralResourceConfig(clusterID string, admin bool) string {
+	return fmt.Sprintf(`
+	ephemeral "ibm_container_cluster_config" "config" {
+		cluster_name_id = "%s"
+		admin           = %t
+	}
+`, clusterID, admin)
+}
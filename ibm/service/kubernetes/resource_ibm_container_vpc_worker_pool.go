@@ -35,6 +35,7 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
@@ -162,6 +163,26 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Description: "The operating system of the workers in the worker pool.",
 			},
 
+			"update_all_workers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Replaces the workers of the worker pool that are not at the Kubernetes version or operating system of the worker pool when the operating system is updated",
+			},
+
+			"patch_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Kubernetes patch version, replaces the workers of the worker pool that are not at the Kubernetes version of the cluster when updated",
+			},
+
+			"retry_patch_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Argument which helps to retry the patch version updates on worker nodes. Increment the value to retry the patch updates if the previous apply fails",
+			},
+
+			"update_strategy": workerUpdateStrategySchema(),
+
 			"secondary_storage": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -543,6 +564,16 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	// Replace the workers after the operating system of the worker pool or the
+	// master node kube-version is updated.
+	updateAllWorkers := d.Get("update_all_workers").(bool)
+	if (updateAllWorkers && (d.HasChange("operating_system") || d.HasChange("update_all_workers"))) || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
+		if err := updateVpcWorkers(d, meta, clusterNameOrID, workerPoolName, true); err != nil {
+			d.Set("patch_version", nil)
+			return fmt.Errorf("[ERROR] Error updating the worker nodes of worker pool (%s): %s", workerPoolName, err)
+		}
+	}
+
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
@@ -690,3 +690,90 @@ func testAccCheckIBMOpcContainerWorkerPoolBasic(name, openshiftFlavour, openShif
 	}
 		`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.IksClusterSubnetID, openshiftFlavour, openShiftworkerCount, operatingSystem)
 }
+
+func TestAccIBMContainerVpcOpenshiftClusterWorkerPoolResourceUpdateStrategy(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-oc-wp-strategy-%d", acctest.RandIntRange(10, 100))
+	openshiftFlavour := "bx2.16x64"
+	openShiftworkerCount := "2"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMVpcContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMOpcContainerWorkerPoolUpdateStrategy(name, openshiftFlavour, openShiftworkerCount, "REDHAT_8_64"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "operating_system", "REDHAT_8_64"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "update_strategy.0.max_unavailable", "50%"),
+				),
+			},
+			{
+				Config: testAccCheckIBMOpcContainerWorkerPoolUpdateStrategy(name, openshiftFlavour, openShiftworkerCount, "RHCOS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "operating_system", "RHCOS"),
+					testAccCheckIBMVpcContainerWorkerPoolWorkersOperatingSystem("ibm_container_vpc_worker_pool.test_pool", "RHCOS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpcContainerWorkerPoolWorkersOperatingSystem(n, operatingSystem string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		csClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcContainerAPI()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		workers, err := csClient.Workers().ListByWorkerPool(parts[0], parts[1], false, v2.ClusterTargetHeader{})
+		if err != nil {
+			return err
+		}
+		for _, worker := range workers {
+			if worker.LifeCycle.ActualOperatingSystem != operatingSystem {
+				return fmt.Errorf("Worker %s runs %s, expected %s", worker.ID, worker.LifeCycle.ActualOperatingSystem, operatingSystem)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMOpcContainerWorkerPoolUpdateStrategy(name, openshiftFlavour, openShiftworkerCount, operatingSystem string) string {
+	return testAccCheckIBMContainerOcpClusterBasic(name, openshiftFlavour, openShiftworkerCount, "REDHAT_8_64") +
+		fmt.Sprintf(`
+
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster            = ibm_container_vpc_cluster.cluster.id
+	  worker_pool_name   = "%[1]s"
+	  flavor             = "%[5]s"
+	  worker_count       = "%[6]s"
+	  vpc_id             = "%[2]s"
+	  resource_group_id  = "%[3]s"
+	  operating_system   = "%[7]s"
+	  entitlement        = "cloud_pak"
+	  update_all_workers = true
+	  zones {
+		subnet_id = "%[4]s"
+		name      = "us-south-1"
+	  }
+	  update_strategy {
+		max_unavailable  = "50%%"
+		zone_order       = ["us-south-1"]
+		pause_on_failure = true
+	  }
+	}
+		`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.IksClusterSubnetID, openshiftFlavour, openShiftworkerCount, operatingSystem)
+}
@@ -0,0 +1,401 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	workerUpdateMaxUnavailableDefault = "1"
+	workerReplacementCreating         = "creating"
+	workerReplacementCreated          = "created"
+)
+
+// workerUpdateStrategySchema returns the update_strategy block of the
+// resources replacing VPC worker nodes when they are updated.
+func workerUpdateStrategySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "How the worker nodes are replaced when they are updated",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_unavailable": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      workerUpdateMaxUnavailableDefault,
+					ValidateFunc: validateWorkerMaxUnavailable,
+					Description:  "The maximum number of worker nodes replaced at the same time, as a count (2) or a percentage of the worker nodes (25%)",
+				},
+				"zone_order": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Update the zones one after another, in this order. Zones that are not listed are updated last",
+				},
+				"pause_on_failure": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Stop starting new worker node replacements when a replacement fails",
+				},
+			},
+		},
+	}
+}
+
+type workerUpdateStrategy struct {
+	maxUnavailable string
+	zoneOrder      []string
+	pauseOnFailure bool
+}
+
+func expandWorkerUpdateStrategy(l []interface{}) workerUpdateStrategy {
+	strategy := workerUpdateStrategy{
+		maxUnavailable: workerUpdateMaxUnavailableDefault,
+		pauseOnFailure: true,
+	}
+	if len(l) == 0 || l[0] == nil {
+		return strategy
+	}
+
+	s := l[0].(map[string]interface{})
+	if v, ok := s["max_unavailable"].(string); ok && v != "" {
+		strategy.maxUnavailable = v
+	}
+	if v, ok := s["zone_order"].([]interface{}); ok {
+		for _, zone := range v {
+			strategy.zoneOrder = append(strategy.zoneOrder, zone.(string))
+		}
+	}
+	if v, ok := s["pause_on_failure"].(bool); ok {
+		strategy.pauseOnFailure = v
+	}
+	return strategy
+}
+
+// parseWorkerMaxUnavailable returns the number of worker nodes that can be
+// replaced at the same time out of total worker nodes. A percentage is
+// rounded down, but at least one worker node is replaced at a time.
+func parseWorkerMaxUnavailable(maxUnavailable string, total int) (int, error) {
+	value := strings.TrimSpace(maxUnavailable)
+	if percentage, ok := strings.CutSuffix(value, "%"); ok {
+		p, err := strconv.Atoi(percentage)
+		if err != nil || p < 1 || p > 100 {
+			return 0, fmt.Errorf("max_unavailable must be a percentage between 1%% and 100%%, got %q", maxUnavailable)
+		}
+		return max(total*p/100, 1), nil
+	}
+
+	count, err := strconv.Atoi(value)
+	if err != nil || count < 1 {
+		return 0, fmt.Errorf("max_unavailable must be a positive number of worker nodes or a percentage, got %q", maxUnavailable)
+	}
+	return count, nil
+}
+
+func validateWorkerMaxUnavailable(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseWorkerMaxUnavailable(v.(string), 1); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// outdatedWorker is a worker node whose Kubernetes version or operating
+// system differs from the one of its worker pool.
+type outdatedWorker struct {
+	ID     string
+	PoolID string
+	Zone   string
+}
+
+// batches returns the worker nodes in the order they are replaced. Without a
+// zone order all worker nodes are replaced in a single batch, otherwise there
+// is one batch per zone, with the zones that are not listed last.
+func (s workerUpdateStrategy) batches(workers []outdatedWorker) [][]outdatedWorker {
+	if len(s.zoneOrder) == 0 {
+		return [][]outdatedWorker{workers}
+	}
+
+	byZone := make(map[string][]outdatedWorker)
+	for _, worker := range workers {
+		byZone[worker.Zone] = append(byZone[worker.Zone], worker)
+	}
+
+	batches := make([][]outdatedWorker, 0, len(byZone))
+	for _, zone := range s.zoneOrder {
+		if batch, ok := byZone[zone]; ok {
+			batches = append(batches, batch)
+			delete(byZone, zone)
+		}
+	}
+	remaining := make([]string, 0, len(byZone))
+	for zone := range byZone {
+		remaining = append(remaining, zone)
+	}
+	sort.Strings(remaining)
+	for _, zone := range remaining {
+		batches = append(batches, byZone[zone])
+	}
+	return batches
+}
+
+// runWorkerUpdates replaces the worker nodes batch after batch, with at most
+// parallelism replacements in progress at the same time. When the strategy
+// pauses on failure no new replacement is started after one fails, the
+// replacements in progress are completed.
+func runWorkerUpdates(strategy workerUpdateStrategy, parallelism int, workers []outdatedWorker, replace func(outdatedWorker) error) error {
+	var (
+		mu       sync.Mutex
+		failures []string
+		replaced int
+	)
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(failures) > 0
+	}
+
+	slots := make(chan struct{}, parallelism)
+	for _, batch := range strategy.batches(workers) {
+		var wg sync.WaitGroup
+		for _, worker := range batch {
+			slots <- struct{}{}
+			if strategy.pauseOnFailure && failed() {
+				<-slots
+				break
+			}
+
+			wg.Add(1)
+			go func(worker outdatedWorker) {
+				defer func() {
+					<-slots
+					wg.Done()
+				}()
+				err := replace(worker)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					failures = append(failures, fmt.Sprintf("%s: %s", worker.ID, err))
+					return
+				}
+				replaced++
+			}(worker)
+		}
+		wg.Wait()
+
+		if strategy.pauseOnFailure && failed() {
+			break
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+	sort.Strings(failures)
+	return fmt.Errorf("%d worker node(s) failed to be replaced, %d replaced, %d not started:\n%s",
+		len(failures), replaced, len(workers)-len(failures)-replaced, strings.Join(failures, "\n"))
+}
+
+// updateVpcWorkers replaces the worker nodes of the cluster, or of one of its
+// worker pools when workerPool is set, that are not at the Kubernetes version
+// or operating system of their worker pool, following the update_strategy of
+// the resource.
+func updateVpcWorkers(d *schema.ResourceData, meta interface{}, clusterID, workerPool string, waitForWorkerUpdate bool) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d)
+	if err != nil {
+		return err
+	}
+
+	var workers []v2.Worker
+	if workerPool == "" {
+		workers, err = csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+	} else {
+		workers, err = csClient.Workers().ListByWorkerPool(clusterID, workerPool, false, targetEnv)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+
+	operatingSystems := make(map[string]string)
+	var outdated []outdatedWorker
+	for _, worker := range workers {
+		operatingSystem, ok := operatingSystems[worker.PoolID]
+		if !ok {
+			pool, err := csClient.WorkerPools().GetWorkerPool(clusterID, worker.PoolID, targetEnv)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err)
+			}
+			operatingSystem = pool.OperatingSystem
+			operatingSystems[worker.PoolID] = operatingSystem
+		}
+
+		// check if change is present in MAJOR.MINOR version or in PATCH version
+		if worker.KubeVersion.Actual != worker.KubeVersion.Target || worker.LifeCycle.ActualOperatingSystem != operatingSystem {
+			outdated = append(outdated, outdatedWorker{ID: worker.ID, PoolID: worker.PoolID, Zone: worker.Location})
+		}
+	}
+	if len(outdated) == 0 {
+		return nil
+	}
+
+	strategy := expandWorkerUpdateStrategy(d.Get("update_strategy").([]interface{}))
+	parallelism, err := parseWorkerMaxUnavailable(strategy.maxUnavailable, len(workers))
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Replacing %d of the %d worker nodes of cluster %s, %d at a time", len(outdated), len(workers), clusterID, parallelism)
+
+	replacer := &vpcWorkerReplacer{
+		client:    csClient.Workers(),
+		clusterID: clusterID,
+		target:    targetEnv,
+		timeout:   d.Timeout(schema.TimeoutUpdate),
+		known:     make(map[string]bool, len(workers)),
+	}
+	for _, worker := range workers {
+		replacer.known[worker.ID] = true
+	}
+	if !waitForWorkerUpdate {
+		return runWorkerUpdates(strategy, parallelism, outdated, replacer.requestReplacement)
+	}
+	return runWorkerUpdates(strategy, parallelism, outdated, replacer.replace)
+}
+
+// vpcWorkerReplacer replaces the worker nodes of a VPC cluster. The
+// replacement of a worker node is the new worker node of the same worker pool
+// and zone that is not known yet, known is shared by the concurrent
+// replacements so that each new worker node is only claimed once.
+type vpcWorkerReplacer struct {
+	client    v2.Workers
+	clusterID string
+	target    v2.ClusterTargetHeader
+	timeout   time.Duration
+
+	mu    sync.Mutex
+	known map[string]bool
+}
+
+func (r *vpcWorkerReplacer) requestReplacement(worker outdatedWorker) error {
+	_, err := r.client.ReplaceWokerNode(r.clusterID, worker.ID, r.target)
+	// As API returns http response 204 NO CONTENT, error raised will be exempted.
+	if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+		return fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
+	}
+	return nil
+}
+
+// replace replaces the worker node and waits until its replacement is Ready.
+func (r *vpcWorkerReplacer) replace(worker outdatedWorker) error {
+	if err := r.requestReplacement(worker); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Waiting for worker node %s in zone %s to be replaced", worker.ID, worker.Zone)
+	if _, err := r.waitForDeleted(worker.ID); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for worker node %s to be deleted: %s", worker.ID, err)
+	}
+	newWorker, err := r.waitForReplacement(worker)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Failed to spawn new worker node: %s", err)
+	}
+	newWorkerID := newWorker.(v2.Worker).ID
+	if _, err := r.waitForReady(newWorkerID); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for new worker node %s to be Ready: %s", newWorkerID, err)
+	}
+	log.Printf("[INFO] Worker node %s replaced by %s", worker.ID, newWorkerID)
+	return nil
+}
+
+func (r *vpcWorkerReplacer) waitForDeleted(workerID string) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workerDeletePending},
+		Target:  []string{workerDeleteState},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := r.client.Get(r.clusterID, workerID, r.target)
+			if err != nil {
+				return worker, workerDeletePending, nil
+			}
+			if worker.LifeCycle.ActualState == "deleted" {
+				return worker, workerDeleteState, nil
+			}
+			return worker, workerDeletePending, nil
+		},
+		Timeout:      r.timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func (r *vpcWorkerReplacer) waitForReplacement(worker outdatedWorker) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workerReplacementCreating},
+		Target:  []string{workerReplacementCreated},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := r.client.ListByWorkerPool(r.clusterID, worker.PoolID, false, r.target)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error in retriving the list of worker nodes: %s", err)
+			}
+
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			for _, w := range workers {
+				if w.Location == worker.Zone && !r.known[w.ID] {
+					r.known[w.ID] = true
+					log.Printf("[INFO] Found new worker node %s replacing %s", w.ID, worker.ID)
+					return w, workerReplacementCreated, nil
+				}
+			}
+			return workers, workerReplacementCreating, nil
+		},
+		Timeout:      r.timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+// waitForReady waits until the worker node is deployed and reported healthy.
+func (r *vpcWorkerReplacer) waitForReady(workerID string) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", versionUpdating},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := r.client.Get(r.clusterID, workerID, r.target)
+			if err != nil {
+				return nil, "retry", fmt.Errorf("[ERROR] Error retrieving worker of container vpc cluster: %s", err)
+			}
+			if worker.Health.State == normal && worker.LifeCycle.ActualState == workerDesired {
+				return worker, workerNormal, nil
+			}
+			return worker, versionUpdating, nil
+		},
+		Timeout:                   r.timeout,
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	return stateConf.WaitForState()
+}
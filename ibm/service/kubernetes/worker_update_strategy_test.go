@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWorkerMaxUnavailable(t *testing.T) {
+	testcases := []struct {
+		maxUnavailable string
+		total          int
+		expected       int
+		expectError    bool
+	}{
+		{maxUnavailable: "1", total: 9, expected: 1},
+		{maxUnavailable: "3", total: 2, expected: 3},
+		{maxUnavailable: "25%", total: 9, expected: 2},
+		{maxUnavailable: "10%", total: 3, expected: 1},
+		{maxUnavailable: "100%", total: 6, expected: 6},
+		{maxUnavailable: "0", expectError: true},
+		{maxUnavailable: "150%", expectError: true},
+		{maxUnavailable: "half", expectError: true},
+	}
+	for _, tc := range testcases {
+		count, err := parseWorkerMaxUnavailable(tc.maxUnavailable, tc.total)
+		if tc.expectError {
+			assert.Error(t, err, tc.maxUnavailable)
+			continue
+		}
+		require.NoError(t, err, tc.maxUnavailable)
+		assert.Equal(t, tc.expected, count, tc.maxUnavailable)
+	}
+}
+
+func TestWorkerUpdateStrategyBatches(t *testing.T) {
+	workers := []outdatedWorker{
+		{ID: "w1", Zone: "us-south-1"},
+		{ID: "w2", Zone: "us-south-2"},
+		{ID: "w3", Zone: "us-south-3"},
+		{ID: "w4", Zone: "us-south-1"},
+	}
+
+	strategy := expandWorkerUpdateStrategy(nil)
+	assert.Equal(t, [][]outdatedWorker{workers}, strategy.batches(workers))
+
+	strategy = expandWorkerUpdateStrategy([]interface{}{map[string]interface{}{
+		"max_unavailable":  "2",
+		"zone_order":       []interface{}{"us-south-2"},
+		"pause_on_failure": false,
+	}})
+	assert.Equal(t, [][]outdatedWorker{
+		{workers[1]},
+		{workers[0], workers[3]},
+		{workers[2]},
+	}, strategy.batches(workers))
+}
+
+func TestRunWorkerUpdates(t *testing.T) {
+	workers := []outdatedWorker{
+		{ID: "w1", Zone: "us-south-1"},
+		{ID: "w2", Zone: "us-south-1"},
+		{ID: "w3", Zone: "us-south-1"},
+		{ID: "w4", Zone: "us-south-2"},
+		{ID: "w5", Zone: "us-south-2"},
+	}
+
+	t.Run("bounded parallelism", func(t *testing.T) {
+		var mu sync.Mutex
+		var running, maxRunning int
+		var replaced []string
+		err := runWorkerUpdates(expandWorkerUpdateStrategy(nil), 2, workers, func(worker outdatedWorker) error {
+			mu.Lock()
+			running++
+			maxRunning = max(maxRunning, running)
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			replaced = append(replaced, worker.ID)
+			mu.Unlock()
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, maxRunning)
+		assert.ElementsMatch(t, []string{"w1", "w2", "w3", "w4", "w5"}, replaced)
+	})
+
+	t.Run("zones one after another", func(t *testing.T) {
+		var mu sync.Mutex
+		var zones []string
+		strategy := workerUpdateStrategy{zoneOrder: []string{"us-south-2", "us-south-1"}, pauseOnFailure: true}
+		err := runWorkerUpdates(strategy, 5, workers, func(worker outdatedWorker) error {
+			mu.Lock()
+			defer mu.Unlock()
+			zones = append(zones, worker.Zone)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"us-south-2", "us-south-2", "us-south-1", "us-south-1", "us-south-1"}, zones)
+	})
+
+	t.Run("pause on failure", func(t *testing.T) {
+		var mu sync.Mutex
+		var started []string
+		err := runWorkerUpdates(expandWorkerUpdateStrategy(nil), 1, workers, func(worker outdatedWorker) error {
+			mu.Lock()
+			defer mu.Unlock()
+			started = append(started, worker.ID)
+			if worker.ID == "w2" {
+				return fmt.Errorf("worker did not become ready")
+			}
+			return nil
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "1 worker node(s) failed to be replaced, 1 replaced, 3 not started")
+		assert.Equal(t, []string{"w1", "w2"}, started)
+	})
+
+	t.Run("continue on failure", func(t *testing.T) {
+		strategy := workerUpdateStrategy{pauseOnFailure: false}
+		err := runWorkerUpdates(strategy, 1, workers, func(worker outdatedWorker) error {
+			if worker.ID == "w2" || worker.ID == "w4" {
+				return fmt.Errorf("worker did not become ready")
+			}
+			return nil
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "2 worker node(s) failed to be replaced, 3 replaced, 0 not started")
+	})
+}
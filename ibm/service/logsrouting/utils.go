@@ -23,8 +23,16 @@ func updateClientURLWithEndpoint(logsRoutingClient *ibmcloudlogsroutingv0.IBMClo
 
 	log.Printf("[DEBUG] Logs Routing Visibility:: %s, PrivateEndpointType: %s, Region: %s", visibility, privateEndpointType, region)
 
-	if endpointsFile != "" && visibility != "public-and-private" {
-		newServiceURL = conns.FileFallBack(endpointsFile, visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", region, originalConfigServiceURL)
+	if endpointsFile != "" {
+		defaultServiceURL := originalConfigServiceURL
+		if visibility == "public-and-private" {
+			defaultServiceURL = buildEndpointURL(originalConfigServiceURL, region, visibility, privateEndpointType)
+		}
+		var err error
+		newServiceURL, err = conns.FileFallBack(endpointsFile, visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", region, defaultServiceURL)
+		if err != nil {
+			return nil, region, err
+		}
 	} else {
 		newServiceURL = buildEndpointURL(originalConfigServiceURL, region, visibility, privateEndpointType)
 	}
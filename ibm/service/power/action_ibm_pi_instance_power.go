@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = &piInstancePowerAction{}
+	_ action.ActionWithConfigure      = &piInstancePowerAction{}
+	_ action.ActionWithValidateConfig = &piInstancePowerAction{}
+)
+
+func NewPIInstancePowerAction() action.Action {
+	return &piInstancePowerAction{}
+}
+
+type piInstancePowerAction struct {
+	session *ibmpisession.IBMPISession
+}
+
+type piInstancePowerModel struct {
+	Action          types.String `tfsdk:"pi_action"`
+	CloudInstanceID types.String `tfsdk:"pi_cloud_instance_id"`
+	HealthStatus    types.String `tfsdk:"pi_health_status"`
+	InstanceID      types.String `tfsdk:"pi_instance_id"`
+	WaitTimeout     types.Int64  `tfsdk:"pi_wait_timeout"`
+}
+
+var piInstancePowerActions = []string{Action_HardReboot, Action_ImmediateShutdown, Action_SoftReboot, Action_Start, Action_Stop}
+
+func (a *piInstancePowerAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_pi_instance_power"
+}
+
+func (a *piInstancePowerAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts, stops or reboots a Power Systems Virtual Server instance and waits until the instance reaches the target status. Unlike the ibm_pi_instance_action resource, nothing is stored in the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			Arg_Action: schema.StringAttribute{
+				Required:    true,
+				Description: "PVM instance power action. Allowable values are: hard-reboot, immediate-shutdown, soft-reboot, start, stop.",
+			},
+			Arg_CloudInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "PI Cloud instance id",
+			},
+			Arg_HealthStatus: schema.StringAttribute{
+				Optional:    true,
+				Description: "The health status the PVM instance must reach once it is active. Allowable values are: OK, WARNING. Default: OK",
+			},
+			Arg_InstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "PVM instance ID",
+			},
+			Arg_WaitTimeout: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds to wait for the PVM instance to reach the target status. If not specified, defaults to 900 seconds (15 minutes).",
+			},
+		},
+	}
+}
+
+func (a *piInstancePowerAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config piInstancePowerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Action.IsNull() && !config.Action.IsUnknown() && !slices.Contains(piInstancePowerActions, config.Action.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(Arg_Action),
+			"Invalid Power Action",
+			fmt.Sprintf("%s must be one of %s, got: %s", Arg_Action, strings.Join(piInstancePowerActions, ", "), config.Action.ValueString()),
+		)
+	}
+	if !config.HealthStatus.IsNull() && !config.HealthStatus.IsUnknown() && !slices.Contains([]string{OK, Warning}, config.HealthStatus.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(Arg_HealthStatus),
+			"Invalid Health Status",
+			fmt.Sprintf("%s must be one of %s, %s, got: %s", Arg_HealthStatus, OK, Warning, config.HealthStatus.ValueString()),
+		)
+	}
+}
+
+func (a *piInstancePowerAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	sess, err := session.IBMPISession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Power Systems Client",
+			"An unexpected error occurred when creating the Power Systems client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"IBMPISession Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.session = sess
+}
+
+func (a *piInstancePowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config piInstancePowerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.session == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Provider Session",
+			"The ibm_pi_instance_power action was invoked before the provider was configured.",
+		)
+		return
+	}
+
+	waitTimeout := 15 * time.Minute
+	if !config.WaitTimeout.IsNull() {
+		waitTimeout = time.Duration(config.WaitTimeout.ValueInt64()) * time.Second
+	}
+
+	targetHealthStatus := OK
+	if !config.HealthStatus.IsNull() {
+		targetHealthStatus = config.HealthStatus.ValueString()
+	}
+
+	cloudInstanceID := config.CloudInstanceID.ValueString()
+	id := config.InstanceID.ValueString()
+	powerAction := config.Action.ValueString()
+
+	targetStatus := State_Active
+	if powerAction == Action_ImmediateShutdown || powerAction == Action_Stop {
+		targetStatus = State_Shutoff
+	}
+
+	client := instance.NewIBMPIInstanceClient(ctx, a.session, cloudInstanceID)
+
+	// Skip start, stop and immediate-shutdown if the instance is already in the desired state
+	if powerAction == Action_Start || powerAction == Action_Stop || powerAction == Action_ImmediateShutdown {
+		pvm, err := client.Get(id)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to get PVM instance '%s': %s", id, err.Error()), "ibm_pi_instance_power", "invoke")
+			resp.Diagnostics.Append(tfErr.GetFrameworkDiag(path.Root(Arg_InstanceID))...)
+			return
+		}
+		if isPIInstancePowerTargetReached(pvm, targetStatus, targetHealthStatus) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("PVM instance '%s' is already %s, nothing to do", id, strings.ToLower(*pvm.Status)),
+			})
+			return
+		}
+	}
+
+	err := client.Action(id, &models.PVMInstanceAction{Action: &powerAction})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to %s PVM instance '%s': %s", powerAction, id, err.Error()), "ibm_pi_instance_power", "invoke")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag(path.Empty())...)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Requested %s of PVM instance '%s', waiting for status '%s' (timeout: %v)...", powerAction, id, targetStatus, waitTimeout),
+	})
+
+	// A reboot ends in the status the instance had before the action, so wait
+	// for the instance to leave that status before waiting for it to return.
+	reboot := powerAction == Action_HardReboot || powerAction == Action_SoftReboot
+	err = a.waitForStatus(ctx, client, id, targetStatus, targetHealthStatus, reboot, waitTimeout, resp.SendProgress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"PVM Instance Power Action Failed",
+			fmt.Sprintf("PVM instance '%s' did not reach status '%s' after %s: %s", id, targetStatus, powerAction, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("PVM instance '%s' is %s", id, targetStatus),
+	})
+}
+
+// waitForStatus polls the PVM instance until it reaches the target status.
+// If leaveTarget is set, the instance must first be seen outside the target
+// status, so a reboot is not reported as done before it has started.
+func (a *piInstancePowerAction) waitForStatus(ctx context.Context, client *instance.IBMPIInstanceClient, id, targetStatus, targetHealthStatus string, leaveTarget bool, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) error {
+	deadline := time.Now().Add(timeout)
+	pollInterval := 30 * time.Second
+	maxInterval := 2 * time.Minute
+	backoffMultiplier := 1.5
+	lastStatus := ""
+
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("operation cancelled: %w", ctx.Err())
+		case <-time.After(pollInterval):
+		}
+
+		pvm, err := client.Get(id)
+		if err != nil {
+			return fmt.Errorf("failed to get PVM instance status: %w", err)
+		}
+
+		status := "unknown"
+		if pvm.Status != nil {
+			status = strings.ToLower(*pvm.Status)
+		}
+		currentStatus := status
+		if pvm.Health != nil && pvm.Health.Status != "" {
+			currentStatus = fmt.Sprintf("%s (health: %s)", currentStatus, pvm.Health.Status)
+		}
+		if currentStatus != lastStatus {
+			sendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("PVM instance status: %s", currentStatus),
+			})
+			lastStatus = currentStatus
+		}
+
+		if isPIInstancePowerTargetReached(pvm, targetStatus, targetHealthStatus) {
+			if !leaveTarget {
+				return nil
+			}
+		} else {
+			leaveTarget = false
+		}
+		if status == State_Error {
+			if pvm.Fault != nil {
+				return fmt.Errorf("failed to perform the action on the instance: %s", pvm.Fault.Message)
+			}
+			return fmt.Errorf("failed to perform the action on the instance")
+		}
+
+		pollInterval = time.Duration(float64(pollInterval) * backoffMultiplier)
+		if pollInterval > maxInterval {
+			pollInterval = maxInterval
+		}
+	}
+
+	return fmt.Errorf("timeout after %v", timeout)
+}
+
+func isPIInstancePowerTargetReached(pvm *models.PVMInstance, targetStatus, targetHealthStatus string) bool {
+	if pvm.Status == nil || strings.ToLower(*pvm.Status) != targetStatus {
+		return false
+	}
+	return pvm.Health != nil && (pvm.Health.Status == targetHealthStatus || pvm.Health.Status == OK)
+}
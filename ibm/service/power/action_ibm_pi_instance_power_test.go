@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIInstancePowerAction(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstancePowerActionConfig(name, power.Action_Stop),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_pi_instance.power_instance", "instance_id"),
+					testAccCheckIBMPIInstancePowerActionStatus("ibm_pi_instance.power_instance", power.State_Shutoff),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPIInstancePowerActionInvalidAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					action "ibm_pi_instance_power" "example" {
+						config {
+							pi_action            = "%s"
+							pi_cloud_instance_id = "%s"
+							pi_instance_id       = "instance"
+						}
+					}
+				`, power.Action_ResetState, acc.Pi_cloud_instance_id),
+				ExpectError: regexp.MustCompile("Invalid Power Action"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstancePowerActionStatus(n, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		cloudInstanceID := rs.Primary.Attributes[power.Arg_CloudInstanceID]
+		client := instance.NewIBMPIInstanceClient(context.Background(), sess, cloudInstanceID)
+
+		pvm, err := client.Get(rs.Primary.Attributes[power.Attr_InstanceID])
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(*pvm.Status, status) {
+			return fmt.Errorf("PVM instance %s is %s, expected %s", *pvm.PvmInstanceID, *pvm.Status, status)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPIInstancePowerActionConfig(name, action string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_name        = "%[4]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[5]s"
+	}
+
+	action "ibm_pi_instance_power" "example" {
+		config {
+			pi_action            = "%[3]s"
+			pi_cloud_instance_id = "%[1]s"
+			pi_instance_id       = ibm_pi_instance.power_instance.instance_id
+		}
+	}
+
+	resource "ibm_pi_instance" "power_instance" {
+		pi_cloud_instance_id  = "%[1]s"
+		pi_image_id           = data.ibm_pi_image.power_image.id
+		pi_instance_name      = "%[2]s"
+		pi_memory             = "2"
+		pi_proc_type          = "shared"
+		pi_processors         = "0.25"
+		pi_storage_pool       = data.ibm_pi_image.power_image.storage_pool
+		pi_storage_type       = "%[6]s"
+		pi_sys_type           = "s922"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_pi_instance_power.example]
+			}
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, action, acc.Pi_image, acc.Pi_network_name, acc.PiStorageType)
+}
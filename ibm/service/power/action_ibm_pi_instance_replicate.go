@@ -0,0 +1,448 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = &piInstanceReplicateAction{}
+	_ action.ActionWithConfigure      = &piInstanceReplicateAction{}
+	_ action.ActionWithValidateConfig = &piInstanceReplicateAction{}
+)
+
+func NewPIInstanceReplicateAction() action.Action {
+	return &piInstanceReplicateAction{}
+}
+
+// piInstanceReplicateAction captures a PVM instance to Cloud Object Storage
+// and imports the captured image into another workspace, possibly in another
+// zone.
+type piInstanceReplicateAction struct {
+	session *ibmpisession.IBMPISession
+}
+
+type piInstanceReplicateModel struct {
+	CaptureCloudStorageAccessKey types.String `tfsdk:"pi_capture_cloud_storage_access_key"`
+	CaptureCloudStorageRegion    types.String `tfsdk:"pi_capture_cloud_storage_region"`
+	CaptureCloudStorageSecretKey types.String `tfsdk:"pi_capture_cloud_storage_secret_key"`
+	CaptureDestination           types.String `tfsdk:"pi_capture_destination"`
+	CaptureName                  types.String `tfsdk:"pi_capture_name"`
+	CaptureStorageImagePath      types.String `tfsdk:"pi_capture_storage_image_path"`
+	CaptureVolumeIDs             types.List   `tfsdk:"pi_capture_volume_ids"`
+	CloudInstanceID              types.String `tfsdk:"pi_cloud_instance_id"`
+	ImageBucketFileName          types.String `tfsdk:"pi_image_bucket_file_name"`
+	ImageStoragePool             types.String `tfsdk:"pi_image_storage_pool"`
+	ImageStorageType             types.String `tfsdk:"pi_image_storage_type"`
+	InstanceID                   types.String `tfsdk:"pi_instance_id"`
+	TargetCloudInstanceID        types.String `tfsdk:"pi_target_cloud_instance_id"`
+	TargetImageName              types.String `tfsdk:"pi_target_image_name"`
+	TargetZone                   types.String `tfsdk:"pi_target_zone"`
+	WaitTimeout                  types.Int64  `tfsdk:"pi_wait_timeout"`
+}
+
+var piInstanceReplicateDestinations = []string{CloudStorage, Both, ImageCatalog}
+
+func (a *piInstanceReplicateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_pi_instance_replicate"
+}
+
+func (a *piInstanceReplicateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Captures a Power Systems Virtual Server instance with its volumes to Cloud Object Storage and imports the captured image into a target workspace, which can be in another zone.",
+		Attributes: map[string]schema.Attribute{
+			Arg_CaptureCloudStorageAccessKey: schema.StringAttribute{
+				Required:    true,
+				WriteOnly:   true,
+				Description: "Cloud Object Storage HMAC access key of the bucket.",
+			},
+			Arg_CaptureCloudStorageRegion: schema.StringAttribute{
+				Required:    true,
+				Description: "Cloud Object Storage region of the bucket.",
+			},
+			Arg_CaptureCloudStorageSecretKey: schema.StringAttribute{
+				Required:    true,
+				WriteOnly:   true,
+				Description: "Cloud Object Storage HMAC secret key of the bucket.",
+			},
+			Arg_CaptureDestination: schema.StringAttribute{
+				Optional:    true,
+				Description: "Destination of the capture. With cloud-storage the instance is captured to the bucket, with both a copy of the image is also kept in the image catalog of the source workspace, and with image-catalog the instance is captured to the image catalog and the image is then exported to the bucket. Allowable values are: cloud-storage, both, image-catalog. Default: cloud-storage",
+			},
+			Arg_CaptureName: schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the capture. It must be unique in the image catalog of the source workspace and in the bucket.",
+			},
+			Arg_CaptureStorageImagePath: schema.StringAttribute{
+				Required:    true,
+				Description: "Cloud Object Storage image path (bucket-name[/folder/../..]). Must be a bucket name without folder if pi_capture_destination is image-catalog.",
+			},
+			Arg_CaptureVolumeIDs: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "List of data volume IDs to capture with the boot volume. If not specified, all the data volumes attached to the PVM instance are captured.",
+			},
+			Arg_CloudInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "PI Cloud instance id of the source workspace",
+			},
+			Arg_ImageBucketFileName: schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the image file in the bucket. If not specified, defaults to the capture name with the .ova.gz extension.",
+			},
+			Arg_ImageStoragePool: schema.StringAttribute{
+				Optional:    true,
+				Description: "Storage pool where the image is imported in the target workspace.",
+			},
+			Arg_ImageStorageType: schema.StringAttribute{
+				Optional:    true,
+				Description: "Storage type of the image imported in the target workspace. If not specified, default is tier3.",
+			},
+			Arg_InstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "PVM instance ID or name",
+			},
+			Arg_TargetCloudInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "PI Cloud instance id of the workspace where the image is imported.",
+			},
+			Arg_TargetImageName: schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the image in the target workspace. If not specified, defaults to the capture name.",
+			},
+			Arg_TargetZone: schema.StringAttribute{
+				Optional:    true,
+				Description: "Zone of the target workspace, such as dal12 or eu-de-1. If not specified, defaults to the zone of the provider.",
+			},
+			Arg_WaitTimeout: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds to wait for the capture and the import to complete. If not specified, defaults to 10800 seconds (3 hours).",
+			},
+		},
+	}
+}
+
+func (a *piInstanceReplicateAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config piInstanceReplicateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.CaptureDestination.IsNull() && !config.CaptureDestination.IsUnknown() && !slices.Contains(piInstanceReplicateDestinations, config.CaptureDestination.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(Arg_CaptureDestination),
+			"Invalid Capture Destination",
+			fmt.Sprintf("%s must be one of %s, got: %s", Arg_CaptureDestination, strings.Join(piInstanceReplicateDestinations, ", "), config.CaptureDestination.ValueString()),
+		)
+	}
+	if config.CaptureDestination.ValueString() == ImageCatalog && strings.Contains(config.CaptureStorageImagePath.ValueString(), "/") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(Arg_CaptureStorageImagePath),
+			"Invalid Storage Image Path",
+			fmt.Sprintf("%s must be a bucket name without folder when %s is %s, got: %s", Arg_CaptureStorageImagePath, Arg_CaptureDestination, ImageCatalog, config.CaptureStorageImagePath.ValueString()),
+		)
+	}
+}
+
+func (a *piInstanceReplicateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	sess, err := session.IBMPISession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Power Systems Client",
+			"An unexpected error occurred when creating the Power Systems client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"IBMPISession Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.session = sess
+}
+
+func (a *piInstanceReplicateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config piInstanceReplicateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.session == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Provider Session",
+			"The ibm_pi_instance_replicate action was invoked before the provider was configured.",
+		)
+		return
+	}
+
+	waitTimeout := 3 * time.Hour
+	if !config.WaitTimeout.IsNull() {
+		waitTimeout = time.Duration(config.WaitTimeout.ValueInt64()) * time.Second
+	}
+	deadline := time.Now().Add(waitTimeout)
+
+	cloudInstanceID := config.CloudInstanceID.ValueString()
+	targetCloudInstanceID := config.TargetCloudInstanceID.ValueString()
+	captureName := config.CaptureName.ValueString()
+	destination := CloudStorage
+	if !config.CaptureDestination.IsNull() {
+		destination = config.CaptureDestination.ValueString()
+	}
+	imagePath := config.CaptureStorageImagePath.ValueString()
+	region := config.CaptureCloudStorageRegion.ValueString()
+	accessKey := config.CaptureCloudStorageAccessKey.ValueString()
+	secretKey := config.CaptureCloudStorageSecretKey.ValueString()
+	fileName := captureName + ".ova.gz"
+	if !config.ImageBucketFileName.IsNull() {
+		fileName = config.ImageBucketFileName.ValueString()
+	}
+	targetImageName := captureName
+	if !config.TargetImageName.IsNull() {
+		targetImageName = config.TargetImageName.ValueString()
+	}
+
+	targetSession, err := a.targetSession(config.TargetZone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(Arg_TargetZone),
+			"Unable to Create Power Systems Client",
+			fmt.Sprintf("Failed to create the Power Systems client of zone '%s': %s", config.TargetZone.ValueString(), err),
+		)
+		return
+	}
+
+	// Phase 1: resolve the PVM instance and the volumes to capture.
+	instanceClient := instance.NewIBMPIInstanceClient(ctx, a.session, cloudInstanceID)
+	pvm, err := instanceClient.Get(config.InstanceID.ValueString())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to get PVM instance '%s': %s", config.InstanceID.ValueString(), err.Error()), "ibm_pi_instance_replicate", "invoke")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag(path.Root(Arg_InstanceID))...)
+		return
+	}
+	instanceID := *pvm.PvmInstanceID
+
+	var volumeIDs []string
+	if !config.CaptureVolumeIDs.IsNull() {
+		resp.Diagnostics.Append(config.CaptureVolumeIDs.ElementsAs(ctx, &volumeIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		volumes, err := instance.NewIBMPIVolumeClient(ctx, a.session, cloudInstanceID).GetAllInstanceVolumes(instanceID)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to get the volumes of PVM instance '%s': %s", instanceID, err.Error()), "ibm_pi_instance_replicate", "invoke")
+			resp.Diagnostics.Append(tfErr.GetFrameworkDiag(path.Root(Arg_InstanceID))...)
+			return
+		}
+		volumeIDs = piInstanceReplicateDataVolumeIDs(volumes)
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Capturing PVM instance '%s' (%s) with %d data volume(s) to %s as '%s' (timeout: %v)...", instanceID, strings.ToLower(flex.StringValue(pvm.Status)), len(volumeIDs), destination, captureName, waitTimeout),
+	})
+
+	// Phase 2: capture the PVM instance.
+	captureBody := &models.PVMInstanceCapture{
+		CaptureDestination: &destination,
+		CaptureName:        &captureName,
+		CaptureVolumeIDs:   volumeIDs,
+	}
+	if destination != ImageCatalog {
+		captureBody.CloudStorageAccessKey = accessKey
+		captureBody.CloudStorageImagePath = imagePath
+		captureBody.CloudStorageRegion = region
+		captureBody.CloudStorageSecretKey = secretKey
+	}
+	captureJob, err := instanceClient.CaptureInstanceToImageCatalogV2(instanceID, captureBody)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to capture PVM instance '%s': %s", instanceID, err.Error()), "ibm_pi_instance_replicate", "invoke")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag(path.Empty())...)
+		return
+	}
+	sourceJobClient := instance.NewIBMPIJobClient(ctx, a.session, cloudInstanceID)
+	if err := waitForPIReplicateJob(ctx, sourceJobClient, *captureJob.ID, "capture", deadline, resp.SendProgress); err != nil {
+		resp.Diagnostics.AddError(
+			"PVM Instance Capture Failed",
+			fmt.Sprintf("The capture of PVM instance '%s' did not complete: %s", instanceID, err),
+		)
+		return
+	}
+
+	// Phase 3: export the captured image to the bucket if it was only
+	// captured to the image catalog.
+	if destination == ImageCatalog {
+		imageClient := instance.NewIBMPIImageClient(ctx, a.session, cloudInstanceID)
+		image, err := imageClient.Get(captureName)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to get captured image '%s': %s", captureName, err.Error()), "ibm_pi_instance_replicate", "invoke")
+			resp.Diagnostics.Append(tfErr.GetFrameworkDiag(path.Empty())...)
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Exporting image '%s' (%s) to bucket '%s'...", captureName, *image.ImageID, imagePath),
+		})
+		exportJob, err := imageClient.ExportImage(*image.ImageID, &models.ExportImage{
+			AccessKey:  &accessKey,
+			BucketName: &imagePath,
+			Region:     region,
+			SecretKey:  secretKey,
+		})
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to export image '%s': %s", *image.ImageID, err.Error()), "ibm_pi_instance_replicate", "invoke")
+			resp.Diagnostics.Append(tfErr.GetFrameworkDiag(path.Empty())...)
+			return
+		}
+		if err := waitForPIReplicateJob(ctx, sourceJobClient, *exportJob.ID, "export", deadline, resp.SendProgress); err != nil {
+			resp.Diagnostics.AddError(
+				"Image Export Failed",
+				fmt.Sprintf("The export of image '%s' to bucket '%s' did not complete: %s", *image.ImageID, imagePath, err),
+			)
+			return
+		}
+	}
+
+	// Phase 4: import the image into the target workspace.
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Importing '%s' from bucket '%s' into workspace '%s' as '%s'...", fileName, imagePath, targetCloudInstanceID, targetImageName),
+	})
+	bucketAccess := Private
+	importBody := &models.CreateCosImageImportJob{
+		AccessKey:     accessKey,
+		BucketAccess:  &bucketAccess,
+		BucketName:    &imagePath,
+		ImageFilename: &fileName,
+		ImageName:     &targetImageName,
+		Region:        &region,
+		SecretKey:     secretKey,
+		StoragePool:   config.ImageStoragePool.ValueString(),
+		StorageType:   config.ImageStorageType.ValueString(),
+	}
+	targetImageClient := instance.NewIBMPIImageClient(ctx, targetSession, targetCloudInstanceID)
+	importJob, err := targetImageClient.CreateCosImage(importBody)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to import '%s' into workspace '%s': %s", fileName, targetCloudInstanceID, err.Error()), "ibm_pi_instance_replicate", "invoke")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag(path.Root(Arg_TargetCloudInstanceID))...)
+		return
+	}
+	targetJobClient := instance.NewIBMPIJobClient(ctx, targetSession, targetCloudInstanceID)
+	if err := waitForPIReplicateJob(ctx, targetJobClient, *importJob.ID, "import", deadline, resp.SendProgress); err != nil {
+		resp.Diagnostics.AddError(
+			"Image Import Failed",
+			fmt.Sprintf("The import of '%s' into workspace '%s' did not complete: %s", fileName, targetCloudInstanceID, err),
+		)
+		return
+	}
+
+	image, err := targetImageClient.Get(targetImageName)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to get imported image '%s': %s", targetImageName, err.Error()), "ibm_pi_instance_replicate", "invoke")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag(path.Empty())...)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("PVM instance '%s' replicated to image '%s' (%s) in workspace '%s'", instanceID, targetImageName, *image.ImageID, targetCloudInstanceID),
+	})
+}
+
+// targetSession returns the session of the target zone, or the provider
+// session if the zone is not specified or is the zone of the provider.
+func (a *piInstanceReplicateAction) targetSession(zone string) (*ibmpisession.IBMPISession, error) {
+	if zone == "" || zone == a.session.Options.Zone {
+		return a.session, nil
+	}
+	return ibmpisession.NewIBMPISession(&ibmpisession.IBMPIOptions{
+		Authenticator: a.session.Options.Authenticator,
+		Debug:         a.session.Options.Debug,
+		UserAccount:   a.session.Options.UserAccount,
+		Zone:          zone,
+	})
+}
+
+// piInstanceReplicateDataVolumeIDs returns the IDs of the volumes that are not
+// boot volumes, since the boot volume is always captured.
+func piInstanceReplicateDataVolumeIDs(volumes *models.Volumes) []string {
+	volumeIDs := []string{}
+	if volumes == nil {
+		return volumeIDs
+	}
+	for _, volume := range volumes.Volumes {
+		if volume == nil || volume.VolumeID == nil || (volume.BootVolume != nil && *volume.BootVolume) {
+			continue
+		}
+		volumeIDs = append(volumeIDs, *volume.VolumeID)
+	}
+	return volumeIDs
+}
+
+// waitForPIReplicateJob polls the job until it completes, reporting each
+// change of its state or progress.
+func waitForPIReplicateJob(ctx context.Context, client *instance.IBMPIJobClient, jobID, phase string, deadline time.Time, sendProgress func(action.InvokeProgressEvent)) error {
+	pollInterval := 30 * time.Second
+	lastStatus := ""
+
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("operation cancelled: %w", ctx.Err())
+		case <-time.After(pollInterval):
+		}
+
+		job, err := client.Get(jobID)
+		if err != nil {
+			return fmt.Errorf("failed to get the status of job '%s': %w", jobID, err)
+		}
+		if job == nil || job.Status == nil || job.Status.State == nil {
+			return fmt.Errorf("failed to get the status of job '%s'", jobID)
+		}
+
+		status := *job.Status.State
+		if job.Status.Progress != nil && *job.Status.Progress != "" {
+			status = fmt.Sprintf("%s (%s)", status, *job.Status.Progress)
+		}
+		if status != lastStatus {
+			sendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Job %s (%s): %s", jobID, phase, status),
+			})
+			lastStatus = status
+		}
+
+		switch *job.Status.State {
+		case State_Completed:
+			return nil
+		case State_Failed:
+			return fmt.Errorf("job '%s' failed: %s", jobID, job.Status.Message)
+		}
+	}
+
+	return fmt.Errorf("timeout waiting for job '%s'", jobID)
+}
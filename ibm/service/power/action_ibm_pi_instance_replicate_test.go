@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIInstanceReplicateAction(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	captureName := fmt.Sprintf("tf-pi-replicate-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceReplicateActionConfig(name, captureName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_pi_instance.power_instance", "instance_id"),
+					testAccCheckIBMPIInstanceReplicateActionImageExists(captureName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPIInstanceReplicateActionInvalidDestination(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					action "ibm_pi_instance_replicate" "example" {
+						config {
+							pi_capture_cloud_storage_access_key = "access"
+							pi_capture_cloud_storage_region     = "us-south"
+							pi_capture_cloud_storage_secret_key = "secret"
+							pi_capture_destination              = "cos"
+							pi_capture_name                     = "capture"
+							pi_capture_storage_image_path       = "bucket"
+							pi_cloud_instance_id                = "%[1]s"
+							pi_instance_id                      = "instance"
+							pi_target_cloud_instance_id         = "%[1]s"
+						}
+					}
+				`, acc.Pi_cloud_instance_id),
+				ExpectError: regexp.MustCompile("Invalid Capture Destination"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceReplicateActionImageExists(imageName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		if acc.Pi_replicate_target_zone != "" && acc.Pi_replicate_target_zone != sess.Options.Zone {
+			// The image is in another zone, the action already verified it.
+			return nil
+		}
+		client := instance.NewIBMPIImageClient(context.Background(), sess, acc.Pi_replicate_target_cloud_instance_id)
+		image, err := client.Get(imageName)
+		if err != nil {
+			return err
+		}
+		return client.Delete(*image.ImageID)
+	}
+}
+
+func testAccCheckIBMPIInstanceReplicateActionConfig(name, captureName string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_name        = "%[4]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[5]s"
+	}
+
+	action "ibm_pi_instance_replicate" "example" {
+		config {
+			pi_capture_cloud_storage_access_key = "%[7]s"
+			pi_capture_cloud_storage_region     = "%[8]s"
+			pi_capture_cloud_storage_secret_key = "%[9]s"
+			pi_capture_name                     = "%[3]s"
+			pi_capture_storage_image_path       = "%[10]s"
+			pi_cloud_instance_id                = "%[1]s"
+			pi_instance_id                      = ibm_pi_instance.power_instance.instance_id
+			pi_target_cloud_instance_id         = "%[11]s"
+			pi_target_zone                      = "%[12]s"
+		}
+	}
+
+	resource "ibm_pi_instance" "power_instance" {
+		pi_cloud_instance_id  = "%[1]s"
+		pi_image_id           = data.ibm_pi_image.power_image.id
+		pi_instance_name      = "%[2]s"
+		pi_memory             = "2"
+		pi_proc_type          = "shared"
+		pi_processors         = "0.25"
+		pi_storage_pool       = data.ibm_pi_image.power_image.storage_pool
+		pi_storage_type       = "%[6]s"
+		pi_sys_type           = "s922"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_pi_instance_replicate.example]
+			}
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, captureName, acc.Pi_image, acc.Pi_network_name, acc.PiStorageType,
+		acc.Pi_capture_cloud_storage_access_key, acc.Pi_capture_cloud_storage_region, acc.Pi_capture_cloud_storage_secret_key,
+		acc.Pi_capture_storage_image_path, acc.Pi_replicate_target_cloud_instance_id, acc.Pi_replicate_target_zone)
+}
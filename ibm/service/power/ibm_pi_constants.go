@@ -9,6 +9,7 @@ const (
 	Arg_AffinityInstance                     = "pi_affinity_instance"
 	Arg_AffinityPolicy                       = "pi_affinity_policy"
 	Arg_AffinityVolume                       = "pi_affinity_volume"
+	Arg_AllowReboot                          = "pi_allow_reboot"
 	Arg_AntiAffinityInstances                = "pi_anti_affinity_instances"
 	Arg_AntiAffinityVolumes                  = "pi_anti_affinity_volumes"
 	Arg_ARPBroadcast                         = "pi_arp_broadcast"
@@ -170,7 +171,10 @@ const (
 	Arg_StorageType                          = "pi_storage_type"
 	Arg_SysType                              = "pi_sys_type"
 	Arg_Target                               = "pi_target"
+	Arg_TargetCloudInstanceID                = "pi_target_cloud_instance_id"
+	Arg_TargetImageName                      = "pi_target_image_name"
 	Arg_TargetStorageTier                    = "pi_target_storage_tier"
+	Arg_TargetZone                           = "pi_target_zone"
 	Arg_Type                                 = "pi_type"
 	Arg_UserData                             = "pi_user_data"
 	Arg_UserTags                             = "pi_user_tags"
@@ -197,6 +201,7 @@ const (
 	Arg_VPMEMVolumeID                        = "pi_vpmem_volume_id"
 	Arg_VPMEMVolumes                         = "pi_vpmem_volumes"
 	Arg_VTL                                  = "vtl"
+	Arg_WaitTimeout                          = "pi_wait_timeout"
 
 	// Attributes
 	Attr_Access                              = "access"
@@ -451,6 +456,7 @@ const (
 	Attr_PVMInstances                        = "pvm_instances"
 	Attr_PVMSnapshots                        = "pvm_snapshots"
 	Attr_Reason                              = "reason"
+	Attr_RebootReasons                       = "reboot_reasons"
 	Attr_Region                              = "region"
 	Attr_RegionStorageTiers                  = "region_storage_tiers"
 	Attr_Remote                              = "remote"
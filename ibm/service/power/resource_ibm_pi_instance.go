@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -42,6 +43,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
 			},
+			resourceIBMPIInstanceRebootCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -64,6 +66,11 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Optional:      true,
 				Type:          schema.TypeString,
 			},
+			Arg_AllowReboot: {
+				Description: "Indicates if an update may shut down or restart the instance; when false, a plan that requires the instance to be shut down or restarted fails. Defaults to true when not set",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			Arg_AntiAffinityInstances: {
 				ConflictsWith: []string{Arg_AntiAffinityVolumes},
 				Description:   "List of pvmInstances to base storage anti-affinity policy against; required if requesting anti-affinity and pi_anti_affinity_volumes is not provided",
@@ -514,6 +521,12 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description: "Progress of the operation",
 				Type:        schema.TypeFloat,
 			},
+			Attr_RebootReasons: {
+				Computed:    true,
+				Description: "Reasons why the planned update shuts down or restarts the instance",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
 			Attr_SharedProcessorPoolID: {
 				Computed:    true,
 				Description: "Shared Processor Pool ID the instance is deployed on",
@@ -1188,6 +1201,115 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	return resourceIBMPIInstanceRead(ctx, d, meta)
 }
 
+// resourceIBMPIInstanceRebootCustomizeDiff reports at plan time the changes that
+// shut down or restart the instance during the update. The plan fails when
+// pi_allow_reboot is false. The reasons are kept in the state after the update
+// and cleared by the next update that does not restart the instance.
+func resourceIBMPIInstanceRebootCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	reasons := piInstanceRebootReasons(diff)
+	if len(reasons) == 0 {
+		// Clearing the reasons of a previous update without other changes would plan an update
+		if old, _ := diff.GetChange(Attr_RebootReasons); len(old.([]interface{})) > 0 && len(diff.GetChangedKeysPrefix("")) > 0 {
+			return diff.SetNew(Attr_RebootReasons, reasons)
+		}
+		return nil
+	}
+	if !piInstanceAllowReboot(diff) {
+		return fmt.Errorf("the update requires the instance to be shut down or restarted, which is not allowed when %s is false: %s", Arg_AllowReboot, strings.Join(reasons, "; "))
+	}
+	log.Printf("[WARN] the update of the pvm instance (%s) shuts down or restarts the instance: %s", diff.Id(), strings.Join(reasons, "; "))
+	return diff.SetNew(Attr_RebootReasons, reasons)
+}
+
+// piInstanceAllowReboot returns pi_allow_reboot, which has no default so that
+// existing instances have no diff; an instance may be restarted when it is not
+// set.
+func piInstanceAllowReboot(diff *schema.ResourceDiff) bool {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return true
+	}
+	allowReboot := config.GetAttr(Arg_AllowReboot)
+	return allowReboot.IsNull() || !allowReboot.IsKnown() || allowReboot.True()
+}
+
+// piInstanceRebootReasons returns the changes that resourceIBMPIInstanceUpdate
+// performs by shutting down or restarting the instance.
+func piInstanceRebootReasons(diff *schema.ResourceDiff) []string {
+	shutoff := strings.ToLower(diff.Get(Attr_Status).(string)) == State_Shutoff
+	reasons := []string{}
+
+	// The lpar is stopped unless it is already shut off, and started after the change
+	for _, arg := range []string{Arg_ProcType, Arg_SAPProfileID} {
+		if !diff.HasChange(arg) {
+			continue
+		}
+		if shutoff {
+			reasons = append(reasons, fmt.Sprintf("changing %s starts the instance, which is shut off", arg))
+		} else {
+			reasons = append(reasons, fmt.Sprintf("changing %s shuts down and restarts the instance", arg))
+		}
+	}
+
+	// Memory and processors beyond the maximum of a running lpar cannot be changed with dlpar
+	if (diff.HasChange(Arg_Memory) || diff.HasChange(Arg_Processors)) && !shutoff {
+		maxMem := diff.Get(Attr_MaxMemory).(float64)
+		maxProcs := diff.Get(Attr_MaxProcessors).(float64)
+		if !diff.NewValueKnown(Arg_Memory) || !diff.NewValueKnown(Arg_Processors) {
+			reasons = append(reasons, fmt.Sprintf("%s or %s is not known until apply and shuts down and restarts the instance if it exceeds %s (%v) or %s (%v)", Arg_Memory, Arg_Processors, Attr_MaxMemory, maxMem, Attr_MaxProcessors, maxProcs))
+		} else {
+			if mem := diff.Get(Arg_Memory).(float64); mem > maxMem {
+				reasons = append(reasons, fmt.Sprintf("changing %s to %v exceeds %s (%v), which shuts down and restarts the instance", Arg_Memory, mem, Attr_MaxMemory, maxMem))
+			}
+			if procs := diff.Get(Arg_Processors).(float64); procs > maxProcs {
+				reasons = append(reasons, fmt.Sprintf("changing %s to %v exceeds %s (%v), which shuts down and restarts the instance", Arg_Processors, procs, Attr_MaxProcessors, maxProcs))
+			}
+		}
+	}
+
+	// The serial and the software tier of the virtual serial number can only be changed on a shut off lpar
+	if !shutoff {
+		if diff.HasChange(Arg_VirtualSerialNumber + ".0." + Attr_Serial) {
+			reasons = append(reasons, fmt.Sprintf("changing the %s of %s shuts down and restarts the instance", Attr_Serial, Arg_VirtualSerialNumber))
+		} else if diff.HasChange(Arg_VirtualSerialNumber + ".0." + Attr_SoftwareTier) {
+			reasons = append(reasons, fmt.Sprintf("changing the %s of %s shuts down and restarts the instance", Attr_SoftwareTier, Arg_VirtualSerialNumber))
+		}
+	}
+
+	// The IBM i software licenses are changed on the active lpar without a restart
+	return reasons
+}
+
+// ResourceIBMPIInstancePlanWarnings returns a warning for the planned state of
+// an ibm_pi_instance whose update shuts down or restarts the instance, listing
+// its reboot_reasons.
+func ResourceIBMPIInstancePlanWarnings(planned cty.Value) diag.Diagnostics {
+	if planned.IsNull() || !planned.IsKnown() || !planned.Type().IsObjectType() || !planned.Type().HasAttribute(Attr_RebootReasons) {
+		return nil
+	}
+	reasons := planned.GetAttr(Attr_RebootReasons)
+	if reasons.IsNull() || !reasons.IsKnown() || reasons.LengthInt() == 0 {
+		return nil
+	}
+	details := []string{}
+	for it := reasons.ElementIterator(); it.Next(); {
+		_, reason := it.Element()
+		if reason.IsKnown() && !reason.IsNull() {
+			details = append(details, "- "+reason.AsString())
+		}
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "The update shuts down or restarts the instance",
+			Detail:   fmt.Sprintf("%s\n\nSet %s to false to fail the plan instead.", strings.Join(details, "\n"), Arg_AllowReboot),
+		},
+	}
+}
+
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
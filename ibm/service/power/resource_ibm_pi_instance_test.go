@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -774,6 +775,68 @@ func testAccCheckIBMPIStoppedInstanceConfigUpdate(name, instanceHealthStatus, pr
 	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, instanceHealthStatus, proc, memory, action)
 }
 
+func TestAccIBMPIInstanceAllowReboot(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceAllowRebootConfig(name, "shared", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_allow_reboot", "false"),
+					resource.TestCheckResourceAttr(instanceRes, "status", strings.ToUpper(power.State_Active)),
+				),
+			},
+			{
+				Config:      testAccCheckIBMPIInstanceAllowRebootConfig(name, "dedicated", false),
+				ExpectError: regexp.MustCompile("not allowed when pi_allow_reboot is false"),
+			},
+			{
+				Config: testAccCheckIBMPIInstanceAllowRebootConfig(name, "dedicated", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceStatus(instanceRes, strings.ToUpper(power.State_Active)),
+					resource.TestCheckResourceAttr(instanceRes, "pi_proc_type", "dedicated"),
+					resource.TestCheckResourceAttr(instanceRes, "reboot_reasons.#", "1"),
+					resource.TestCheckResourceAttr(instanceRes, "reboot_reasons.0", "changing pi_proc_type shuts down and restarts the instance"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceAllowRebootConfig(name, procType string, allowReboot bool) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_name        = "%[3]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_allow_reboot      = %[6]t
+		pi_cloud_instance_id = "%[1]s"
+		pi_health_status     = "OK"
+		pi_image_id          = data.ibm_pi_image.power_image.id
+		pi_instance_name     = "%[2]s"
+		pi_memory            = "2"
+		pi_pin_policy        = "none"
+		pi_proc_type         = "%[5]s"
+		pi_processors        = "1"
+		pi_storage_pool      = data.ibm_pi_image.power_image.storage_pool
+		pi_sys_type          = "s922"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, procType, allowReboot)
+}
+
 func TestAccIBMPIInstanceVirtualSerialNumber(t *testing.T) {
 	instanceRes := "ibm_pi_instance.power_instance"
 	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &schematicsWorkspaceActivityAction{}
+	_ action.ActionWithConfigure = &schematicsWorkspaceActivityAction{}
+)
+
+const (
+	schematicsWorkspacePlan    = "plan"
+	schematicsWorkspaceApply   = "apply"
+	schematicsWorkspaceDestroy = "destroy"
+
+	// schematicsLogExcerptLines is the number of log lines reported when an
+	// activity fails.
+	schematicsLogExcerptLines = 20
+)
+
+func NewSchematicsWorkspacePlanAction() action.Action {
+	return &schematicsWorkspaceActivityAction{command: schematicsWorkspacePlan}
+}
+
+func NewSchematicsWorkspaceApplyAction() action.Action {
+	return &schematicsWorkspaceActivityAction{command: schematicsWorkspaceApply}
+}
+
+func NewSchematicsWorkspaceDestroyAction() action.Action {
+	return &schematicsWorkspaceActivityAction{command: schematicsWorkspaceDestroy}
+}
+
+// schematicsWorkspaceActivityAction runs a plan, apply or destroy activity
+// on a Schematics workspace and streams its logs until it completes.
+type schematicsWorkspaceActivityAction struct {
+	command string
+	session conns.ClientSession
+}
+
+type schematicsWorkspaceActivityModel struct {
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Targets     types.List   `tfsdk:"targets"`
+	WaitTimeout types.Int64  `tfsdk:"wait_timeout"`
+}
+
+func (a *schematicsWorkspaceActivityAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_schematics_workspace_" + a.command
+}
+
+func (a *schematicsWorkspaceActivityAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Runs a Terraform %s on a Schematics workspace and waits until it completes, reporting the logs of the activity.", a.command),
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the workspace.",
+			},
+			"targets": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("The resource addresses to target with the %s, such as module.vpc. All the resources of the workspace are targeted if not specified.", a.command),
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum time in seconds to wait for the %s to complete. If not specified, defaults to 3600 seconds (1 hour).", a.command),
+			},
+		},
+	}
+}
+
+func (a *schematicsWorkspaceActivityAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.session = session
+}
+
+func (a *schematicsWorkspaceActivityAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config schematicsWorkspaceActivityModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.session == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Provider Session",
+			fmt.Sprintf("The ibm_schematics_workspace_%s action was invoked before the provider was configured.", a.command),
+		)
+		return
+	}
+
+	waitTimeout := 3600 * time.Second
+	if !config.WaitTimeout.IsNull() {
+		waitTimeout = time.Duration(config.WaitTimeout.ValueInt64()) * time.Second
+	}
+
+	workspaceID := config.WorkspaceID.ValueString()
+	schematicsClient, err := a.schematicsClient(workspaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Schematics Client", err.Error())
+		return
+	}
+	bmxSession, err := a.session.BluemixSession()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Schematics Client", err.Error())
+		return
+	}
+	refreshToken := bmxSession.Config.IAMRefreshToken
+
+	var actionOptions *schematicsv1.WorkspaceActivityOptionsTemplate
+	if !config.Targets.IsNull() {
+		var targets []string
+		resp.Diagnostics.Append(config.Targets.ElementsAs(ctx, &targets, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actionOptions = &schematicsv1.WorkspaceActivityOptionsTemplate{Target: targets}
+	}
+
+	activityID, response, err := a.startActivity(ctx, schematicsClient, workspaceID, refreshToken, actionOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Start Workspace Activity",
+			fmt.Sprintf("Error starting the %s of workspace '%s': %s\n%s", a.command, workspaceID, err, response),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Workspace %s '%s' started, waiting for completion (timeout: %v)...", a.command, activityID, waitTimeout),
+	})
+
+	if err := a.waitForActivity(ctx, schematicsClient, workspaceID, activityID, waitTimeout, resp.SendProgress); err != nil {
+		resp.Diagnostics.AddError(
+			"Workspace Activity Failed",
+			fmt.Sprintf("The %s '%s' of workspace '%s' did not complete successfully: %s", a.command, activityID, workspaceID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Workspace %s '%s' completed successfully", a.command, activityID),
+	})
+}
+
+// schematicsClient returns a copy of the Schematics client that targets the
+// region of the workspace.
+func (a *schematicsWorkspaceActivityAction) schematicsClient(workspaceID string) (*schematicsv1.SchematicsV1, error) {
+	client, err := a.session.SchematicsV1()
+	if err != nil {
+		return nil, err
+	}
+	client = &schematicsv1.SchematicsV1{
+		Service: client.Service.Clone(),
+	}
+	region := strings.Split(workspaceID, ".")[0]
+	schematicsURL, updatedURL, err := SchematicsEndpointURL(region, a.session)
+	if err != nil {
+		return nil, err
+	}
+	if updatedURL {
+		client.Service.Options.URL = schematicsURL
+	}
+	return client, nil
+}
+
+func (a *schematicsWorkspaceActivityAction) startActivity(ctx context.Context, client *schematicsv1.SchematicsV1, workspaceID, refreshToken string, actionOptions *schematicsv1.WorkspaceActivityOptionsTemplate) (string, *core.DetailedResponse, error) {
+	switch a.command {
+	case schematicsWorkspacePlan:
+		result, response, err := client.PlanWorkspaceCommandWithContext(ctx, &schematicsv1.PlanWorkspaceCommandOptions{
+			WID:           &workspaceID,
+			RefreshToken:  &refreshToken,
+			ActionOptions: actionOptions,
+		})
+		if err != nil {
+			return "", response, err
+		}
+		return core.StringNilMapper(result.Activityid), response, nil
+	case schematicsWorkspaceApply:
+		result, response, err := client.ApplyWorkspaceCommandWithContext(ctx, &schematicsv1.ApplyWorkspaceCommandOptions{
+			WID:           &workspaceID,
+			RefreshToken:  &refreshToken,
+			ActionOptions: actionOptions,
+		})
+		if err != nil {
+			return "", response, err
+		}
+		return core.StringNilMapper(result.Activityid), response, nil
+	default:
+		result, response, err := client.DestroyWorkspaceCommandWithContext(ctx, &schematicsv1.DestroyWorkspaceCommandOptions{
+			WID:           &workspaceID,
+			RefreshToken:  &refreshToken,
+			ActionOptions: actionOptions,
+		})
+		if err != nil {
+			return "", response, err
+		}
+		return core.StringNilMapper(result.Activityid), response, nil
+	}
+}
+
+// waitForActivity polls the activity until it completes. The new lines of
+// the template logs are sent as progress events on each poll, and the end of
+// the logs is returned in the error when the activity fails.
+func (a *schematicsWorkspaceActivityAction) waitForActivity(ctx context.Context, client *schematicsv1.SchematicsV1, workspaceID, activityID string, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) error {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	lastStatus := ""
+	logs := map[string][]string{}
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("operation cancelled: %w", ctx.Err())
+		case <-deadline:
+			return fmt.Errorf("timeout after %v waiting for the %s to complete", timeout, a.command)
+		case <-ticker.C:
+		}
+
+		activity, response, err := client.GetWorkspaceActivityWithContext(ctx, &schematicsv1.GetWorkspaceActivityOptions{
+			WID:        &workspaceID,
+			ActivityID: &activityID,
+		})
+		if err != nil {
+			log.Printf("[DEBUG] Error getting the activity %s of workspace %s: %s\n%s", activityID, workspaceID, err, response)
+			continue
+		}
+
+		for _, template := range activity.Templates {
+			templateID := core.StringNilMapper(template.TemplateID)
+			activityLog, response, err := client.GetTemplateActivityLogWithContext(ctx, &schematicsv1.GetTemplateActivityLogOptions{
+				WID:        &workspaceID,
+				TID:        &templateID,
+				ActivityID: &activityID,
+			})
+			if err != nil || activityLog == nil {
+				log.Printf("[DEBUG] Error getting the log of template %s: %s\n%s", templateID, err, response)
+				continue
+			}
+			lines := strings.Split(strings.TrimRight(*activityLog, "\n"), "\n")
+			for _, line := range lines[min(len(logs[templateID]), len(lines)):] {
+				sendProgress(action.InvokeProgressEvent{Message: line})
+			}
+			logs[templateID] = lines
+		}
+
+		status := core.StringNilMapper(activity.Status)
+		if status != lastStatus {
+			sendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Workspace %s status: %s", a.command, status),
+			})
+			lastStatus = status
+		}
+
+		switch status {
+		case "COMPLETED":
+			return nil
+		case "FAILED", "STOPPED":
+			var excerpt []string
+			for _, template := range activity.Templates {
+				excerpt = append(excerpt, schematicsLogExcerpt(logs[core.StringNilMapper(template.TemplateID)], schematicsLogExcerptLines)...)
+			}
+			if len(excerpt) == 0 {
+				excerpt = activity.Message
+			}
+			return fmt.Errorf("the %s %s:\n%s", a.command, strings.ToLower(status), strings.Join(excerpt, "\n"))
+		}
+	}
+}
+
+// schematicsLogExcerpt returns at most count lines of the log, starting at
+// the first error, or the last lines of the log if it does not report an
+// error.
+func schematicsLogExcerpt(lines []string, count int) []string {
+	for i, line := range lines {
+		if strings.Contains(strings.ToLower(line), "error") {
+			return lines[i:min(i+count, len(lines))]
+		}
+	}
+	return lines[max(len(lines)-count, 0):]
+}
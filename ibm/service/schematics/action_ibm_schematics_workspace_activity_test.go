@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccIBMSchematicsWorkspaceActivityActionsBasic plans and applies the
+// template of a workspace, then destroys its resources, each time the
+// trigger of the corresponding action changes.
+func TestAccIBMSchematicsWorkspaceActivityActionsBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-schematics_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccCheckIBMSchematicsWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceActivityActionsConfig(name, "initial", "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_workspace.schematics_workspace", "name", name),
+				),
+			},
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceActivityActionsConfig(name, "release 1", "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("terraform_data.deploy", "input", "release 1"),
+					testAccCheckIBMSchematicsWorkspaceActivityCompleted("ibm_schematics_workspace.schematics_workspace", "PLAN"),
+					testAccCheckIBMSchematicsWorkspaceActivityCompleted("ibm_schematics_workspace.schematics_workspace", "APPLY"),
+					testAccCheckIBMSchematicsWorkspaceStatus("ibm_schematics_workspace.schematics_workspace", "APPLY", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceActivityActionsConfig(name, "release 1", "teardown"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("terraform_data.teardown", "input", "teardown"),
+					testAccCheckIBMSchematicsWorkspaceActivityCompleted("ibm_schematics_workspace.schematics_workspace", "DESTROY"),
+					testAccCheckIBMSchematicsWorkspaceStatus("ibm_schematics_workspace.schematics_workspace", "DESTROY", "INACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMSchematicsWorkspaceActivityCompleted checks that the latest
+// activity of the workspace named name, such as PLAN, has completed.
+func testAccCheckIBMSchematicsWorkspaceActivityCompleted(n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		activities, err := testAccIBMSchematicsWorkspaceActivities(s, n)
+		if err != nil {
+			return err
+		}
+		for _, activity := range activities {
+			if core.StringNilMapper(activity.Name) != name {
+				continue
+			}
+			if status := core.StringNilMapper(activity.Status); status != "COMPLETED" {
+				return fmt.Errorf("Expected the %s activity %s to be COMPLETED, got %s", name, core.StringNilMapper(activity.ActionID), status)
+			}
+			return nil
+		}
+		return fmt.Errorf("No %s activity found", name)
+	}
+}
+
+// testAccCheckIBMSchematicsWorkspaceStatus checks that the latest activity of
+// the workspace has the name, and that the workspace has the status.
+func testAccCheckIBMSchematicsWorkspaceStatus(n, name, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		activities, err := testAccIBMSchematicsWorkspaceActivities(s, n)
+		if err != nil {
+			return err
+		}
+		if len(activities) == 0 || core.StringNilMapper(activities[0].Name) != name {
+			return fmt.Errorf("Expected the latest activity to be %s", name)
+		}
+
+		schematicsClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SchematicsV1()
+		if err != nil {
+			return err
+		}
+		workspace, _, err := schematicsClient.GetWorkspace(&schematicsv1.GetWorkspaceOptions{
+			WID: core.StringPtr(s.RootModule().Resources[n].Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+		if actual := core.StringNilMapper(workspace.Status); actual != status {
+			return fmt.Errorf("Expected the workspace status to be %s, got %s", status, actual)
+		}
+		return nil
+	}
+}
+
+// testAccIBMSchematicsWorkspaceActivities returns the activities of the
+// workspace, the latest first.
+func testAccIBMSchematicsWorkspaceActivities(s *terraform.State, n string) ([]schematicsv1.WorkspaceActivity, error) {
+	rs, ok := s.RootModule().Resources[n]
+	if !ok {
+		return nil, fmt.Errorf("Not found: %s", n)
+	}
+
+	schematicsClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return nil, err
+	}
+	activities, _, err := schematicsClient.ListWorkspaceActivities(&schematicsv1.ListWorkspaceActivitiesOptions{
+		WID: core.StringPtr(rs.Primary.ID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	actions := activities.Actions
+	sort.SliceStable(actions, func(i, j int) bool {
+		if actions[i].PerformedAt == nil || actions[j].PerformedAt == nil {
+			return actions[j].PerformedAt == nil && actions[i].PerformedAt != nil
+		}
+		return time.Time(*actions[i].PerformedAt).After(time.Time(*actions[j].PerformedAt))
+	})
+	return actions, nil
+}
+
+func testAccCheckIBMSchematicsWorkspaceActivityActionsConfig(name, release, teardown string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_schematics_workspace" "schematics_workspace" {
+			description = "tf-acc-test-schematics-actions"
+			location = "us-east"
+			name = "%s"
+			resource_group = "Default"
+			template_type = "terraform_v1.6"
+			template_git_url = "%s"
+		}
+
+		action "ibm_schematics_workspace_plan" "plan" {
+			config {
+				workspace_id = ibm_schematics_workspace.schematics_workspace.id
+			}
+		}
+
+		action "ibm_schematics_workspace_apply" "apply" {
+			config {
+				workspace_id = ibm_schematics_workspace.schematics_workspace.id
+			}
+		}
+
+		action "ibm_schematics_workspace_destroy" "destroy" {
+			config {
+				workspace_id = ibm_schematics_workspace.schematics_workspace.id
+				wait_timeout = 1800
+			}
+		}
+
+		resource "terraform_data" "deploy" {
+			input = "%s"
+
+			lifecycle {
+				action_trigger {
+					events  = [after_update]
+					actions = [action.ibm_schematics_workspace_plan.plan, action.ibm_schematics_workspace_apply.apply]
+				}
+			}
+		}
+
+		resource "terraform_data" "teardown" {
+			input = "%s"
+
+			lifecycle {
+				action_trigger {
+					events  = [after_update]
+					actions = [action.ibm_schematics_workspace_destroy.destroy]
+				}
+			}
+		}
+	`, name, acc.RepoURL, release, teardown)
+}
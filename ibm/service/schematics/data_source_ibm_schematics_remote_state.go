@@ -0,0 +1,404 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                   = &schematicsRemoteStateDataSource{}
+	_ datasource.DataSourceWithConfigure      = &schematicsRemoteStateDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &schematicsRemoteStateDataSource{}
+)
+
+// schematicsWorkspacesPageLimit is the page size used to list the workspaces
+// when a workspace is looked up by name.
+const schematicsWorkspacesPageLimit = 100
+
+func NewSchematicsRemoteStateDataSource() datasource.DataSource {
+	return &schematicsRemoteStateDataSource{}
+}
+
+// schematicsRemoteStateDataSource reads the outputs of the Terraform state of
+// a workspace template, similar to the terraform_remote_state data source.
+type schematicsRemoteStateDataSource struct {
+	session conns.ClientSession
+}
+
+type schematicsRemoteStateModel struct {
+	WorkspaceID            types.String  `tfsdk:"workspace_id"`
+	Name                   types.String  `tfsdk:"name"`
+	ResourceGroup          types.String  `tfsdk:"resource_group"`
+	Location               types.String  `tfsdk:"location"`
+	TemplateID             types.String  `tfsdk:"template_id"`
+	RejectSensitiveOutputs types.Bool    `tfsdk:"reject_sensitive_outputs"`
+	Outputs                types.Dynamic `tfsdk:"outputs"`
+	SensitiveOutputs       types.Dynamic `tfsdk:"sensitive_outputs"`
+	SensitiveOutputNames   types.List    `tfsdk:"sensitive_output_names"`
+	TerraformVersion       types.String  `tfsdk:"terraform_version"`
+	Serial                 types.Int64   `tfsdk:"serial"`
+	Lineage                types.String  `tfsdk:"lineage"`
+}
+
+func (d *schematicsRemoteStateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "ibm_schematics_remote_state"
+}
+
+func (d *schematicsRemoteStateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the outputs of the Terraform state of a Schematics workspace with their types, like the terraform_remote_state data source.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the workspace. Either workspace_id or name must be specified.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the workspace. The name must identify a single workspace in the location, use resource_group to narrow the lookup.",
+			},
+			"resource_group": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID or name of the resource group of the workspace. Only used to look up the workspace by name.",
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Description: "The region of the workspace. Defaults to the region of workspace_id, or to the provider region when the workspace is looked up by name.",
+			},
+			"template_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the workspace template. Required if the workspace has more than one template.",
+			},
+			"reject_sensitive_outputs": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Fail if the state holds outputs marked as sensitive, instead of returning them in sensitive_outputs.",
+			},
+			"outputs": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "The outputs of the state that are not marked as sensitive, as an object with the type of each output.",
+			},
+			"sensitive_outputs": schema.DynamicAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The outputs of the state that are marked as sensitive, as an object with the type of each output.",
+			},
+			"sensitive_output_names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the outputs that are marked as sensitive.",
+			},
+			"terraform_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of Terraform that wrote the state.",
+			},
+			"serial": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The serial of the state, incremented each time the state changes.",
+			},
+			"lineage": schema.StringAttribute{
+				Computed:    true,
+				Description: "The lineage of the state, assigned when the state was created.",
+			},
+		},
+	}
+}
+
+func (d *schematicsRemoteStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.session = session
+}
+
+func (d *schematicsRemoteStateDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config schematicsRemoteStateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.WorkspaceID.IsUnknown() || config.Name.IsUnknown() {
+		return
+	}
+	if config.WorkspaceID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Invalid Workspace",
+			"Exactly one of workspace_id or name must be specified.",
+		)
+	}
+	if !config.ResourceGroup.IsNull() && config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resource_group"),
+			"Invalid Workspace",
+			"resource_group can only be specified together with name.",
+		)
+	}
+}
+
+func (d *schematicsRemoteStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config schematicsRemoteStateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.session == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Provider Session",
+			"The ibm_schematics_remote_state data source was read before the provider was configured.",
+		)
+		return
+	}
+
+	workspaceID := config.WorkspaceID.ValueString()
+	location := config.Location.ValueString()
+	if location == "" && workspaceID != "" {
+		location = strings.Split(workspaceID, ".")[0]
+	}
+	schematicsClient, err := d.schematicsClient(location)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Schematics Client", err.Error())
+		return
+	}
+
+	if workspaceID == "" {
+		workspaceID, err = schematicsWorkspaceIDByName(ctx, schematicsClient, config.Name.ValueString(), config.ResourceGroup.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Unable to Find Workspace", err.Error())
+			return
+		}
+	}
+
+	templateID := config.TemplateID.ValueString()
+	if templateID == "" {
+		templateID, err = schematicsWorkspaceTemplateID(ctx, schematicsClient, workspaceID)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("template_id"), "Unable to Find Workspace Template", err.Error())
+			return
+		}
+	}
+
+	body, response, err := schematicsWorkspaceTemplateState(ctx, schematicsClient, workspaceID, templateID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Workspace State",
+			fmt.Sprintf("Error getting the state of template '%s' of workspace '%s': %s\n%s", templateID, workspaceID, err, response),
+		)
+		return
+	}
+	state, err := decodeSchematicsTerraformState(body)
+	body.Close()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Workspace State",
+			fmt.Sprintf("Error decoding the state of template '%s' of workspace '%s': %s", templateID, workspaceID, err),
+		)
+		return
+	}
+
+	outputs := state.Outputs
+	if outputs == nil {
+		for _, module := range state.Modules {
+			if len(module.Path) == 1 && module.Path[0] == "root" {
+				outputs = module.Outputs
+			}
+		}
+	}
+
+	attrTypes := map[string]attr.Type{}
+	attrValues := map[string]attr.Value{}
+	sensitiveAttrTypes := map[string]attr.Type{}
+	sensitiveAttrValues := map[string]attr.Value{}
+	sensitiveNames := []string{}
+	for name, output := range outputs {
+		value, diags := schematicsStateOutputValue(output)
+		if diags.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Read Workspace State",
+				fmt.Sprintf("Error converting the value of output '%s': %s", name, diags[0].Detail()),
+			)
+			return
+		}
+		if output.Sensitive {
+			sensitiveNames = append(sensitiveNames, name)
+			sensitiveAttrTypes[name] = value.Type(ctx)
+			sensitiveAttrValues[name] = value
+			continue
+		}
+		attrTypes[name] = value.Type(ctx)
+		attrValues[name] = value
+	}
+	sort.Strings(sensitiveNames)
+
+	if config.RejectSensitiveOutputs.ValueBool() && len(sensitiveNames) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("reject_sensitive_outputs"),
+			"Sensitive Outputs Rejected",
+			fmt.Sprintf("The state of template '%s' of workspace '%s' holds outputs marked as sensitive: %s", templateID, workspaceID, strings.Join(sensitiveNames, ", ")),
+		)
+		return
+	}
+
+	outputsValue, diags := types.ObjectValue(attrTypes, attrValues)
+	resp.Diagnostics.Append(diags...)
+	sensitiveOutputsValue, diags := types.ObjectValue(sensitiveAttrTypes, sensitiveAttrValues)
+	resp.Diagnostics.Append(diags...)
+	sensitiveNamesValue, diags := types.ListValueFrom(ctx, types.StringType, sensitiveNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.WorkspaceID = types.StringValue(workspaceID)
+	config.TemplateID = types.StringValue(templateID)
+	config.Outputs = types.DynamicValue(outputsValue)
+	config.SensitiveOutputs = types.DynamicValue(sensitiveOutputsValue)
+	config.SensitiveOutputNames = sensitiveNamesValue
+	config.TerraformVersion = types.StringValue(state.TerraformVersion)
+	config.Serial = types.Int64Value(state.Serial)
+	config.Lineage = types.StringValue(state.Lineage)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// schematicsClient returns a copy of the Schematics client that targets the
+// location, or the provider region if location is empty.
+func (d *schematicsRemoteStateDataSource) schematicsClient(location string) (*schematicsv1.SchematicsV1, error) {
+	client, err := d.session.SchematicsV1()
+	if err != nil {
+		return nil, err
+	}
+	client = &schematicsv1.SchematicsV1{
+		Service: client.Service.Clone(),
+	}
+	if location == "" {
+		return client, nil
+	}
+	schematicsURL, updatedURL, err := SchematicsEndpointURL(location, d.session)
+	if err != nil {
+		return nil, err
+	}
+	if updatedURL {
+		client.Service.Options.URL = schematicsURL
+	}
+	return client, nil
+}
+
+// schematicsWorkspaceTemplateState returns the body of the state of the
+// workspace template. GetWorkspaceTemplateState unmarshals the state into a
+// TemplateStateStore, which drops the outputs and converts the numbers to
+// float64, so the request is sent with the service of the client instead.
+func schematicsWorkspaceTemplateState(ctx context.Context, client *schematicsv1.SchematicsV1, workspaceID, templateID string) (io.ReadCloser, *core.DetailedResponse, error) {
+	pathParamsMap := map[string]string{
+		"w_id": workspaceID,
+		"t_id": templateID,
+	}
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, `/v1/workspaces/{w_id}/runtime_data/{t_id}/state_store`, pathParamsMap)
+	if err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var body io.ReadCloser
+	response, err := client.Service.Request(request, &body)
+	if err != nil {
+		return nil, response, err
+	}
+	if body == nil {
+		return nil, response, fmt.Errorf("empty state returned for template '%s' of workspace '%s'", templateID, workspaceID)
+	}
+	return body, response, nil
+}
+
+// schematicsWorkspaceIDByName returns the ID of the only workspace with the
+// name, in the resource group if one is given.
+func schematicsWorkspaceIDByName(ctx context.Context, client *schematicsv1.SchematicsV1, name, resourceGroup string) (string, error) {
+	var matches []string
+	offset := int64(0)
+	for {
+		workspaces, response, err := client.ListWorkspacesWithContext(ctx, &schematicsv1.ListWorkspacesOptions{
+			Offset: core.Int64Ptr(offset),
+			Limit:  core.Int64Ptr(schematicsWorkspacesPageLimit),
+		})
+		if err != nil {
+			return "", fmt.Errorf("error listing the workspaces: %s\n%s", err, response)
+		}
+		for _, workspace := range workspaces.Workspaces {
+			if core.StringNilMapper(workspace.Name) != name {
+				continue
+			}
+			if resourceGroup != "" && core.StringNilMapper(workspace.ResourceGroup) != resourceGroup {
+				continue
+			}
+			matches = append(matches, core.StringNilMapper(workspace.ID))
+		}
+		offset += int64(len(workspaces.Workspaces))
+		if len(workspaces.Workspaces) == 0 || workspaces.Count == nil || offset >= *workspaces.Count {
+			break
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no workspace found with name '%s'", name)
+	case 1:
+		log.Printf("[DEBUG] Found workspace %s with name %s", matches[0], name)
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%d workspaces found with name '%s' (%s), specify resource_group or workspace_id", len(matches), name, strings.Join(matches, ", "))
+	}
+}
+
+// schematicsWorkspaceTemplateID returns the ID of the only template of the
+// workspace.
+func schematicsWorkspaceTemplateID(ctx context.Context, client *schematicsv1.SchematicsV1, workspaceID string) (string, error) {
+	workspace, response, err := client.GetWorkspaceWithContext(ctx, &schematicsv1.GetWorkspaceOptions{
+		WID: &workspaceID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error getting workspace '%s': %s\n%s", workspaceID, err, response)
+	}
+	switch len(workspace.TemplateData) {
+	case 0:
+		return "", fmt.Errorf("workspace '%s' has no template", workspaceID)
+	case 1:
+		return core.StringNilMapper(workspace.TemplateData[0].ID), nil
+	default:
+		return "", fmt.Errorf("workspace '%s' has %d templates, specify template_id", workspaceID, len(workspace.TemplateData))
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsRemoteStateDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsRemoteStateDataSourceConfigBasic(acc.WorkspaceID, acc.TemplateID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_schematics_remote_state.remote_state", "workspace_id", acc.WorkspaceID),
+					resource.TestCheckResourceAttr("data.ibm_schematics_remote_state.remote_state", "template_id", acc.TemplateID),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_remote_state.remote_state", "terraform_version"),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_remote_state.remote_state", "serial"),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_remote_state.remote_state", "lineage"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMSchematicsRemoteStateDataSourceByName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsRemoteStateDataSourceConfigByName(acc.WorkspaceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_schematics_remote_state.remote_state", "workspace_id", acc.WorkspaceID),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_remote_state.remote_state", "template_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMSchematicsRemoteStateDataSourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMSchematicsRemoteStateDataSourceConfigInvalid(acc.WorkspaceID),
+				ExpectError: regexp.MustCompile("Exactly one of workspace_id or name must be specified"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsRemoteStateDataSourceConfigBasic(workspaceID, templateID string) string {
+	return fmt.Sprintf(`
+		data "ibm_schematics_remote_state" "remote_state" {
+			workspace_id = "%s"
+			template_id  = "%s"
+		}
+	`, workspaceID, templateID)
+}
+
+func testAccCheckIBMSchematicsRemoteStateDataSourceConfigByName(workspaceID string) string {
+	return fmt.Sprintf(`
+		data "ibm_schematics_workspace" "schematics_workspace" {
+			workspace_id = "%s"
+		}
+
+		data "ibm_schematics_remote_state" "remote_state" {
+			name           = data.ibm_schematics_workspace.schematics_workspace.name
+			resource_group = data.ibm_schematics_workspace.schematics_workspace.resource_group
+			location       = data.ibm_schematics_workspace.schematics_workspace.location
+		}
+	`, workspaceID)
+}
+
+func testAccCheckIBMSchematicsRemoteStateDataSourceConfigInvalid(workspaceID string) string {
+	return fmt.Sprintf(`
+		data "ibm_schematics_remote_state" "remote_state" {
+			workspace_id = "%s"
+			name         = "tf-acc-test-schematics"
+		}
+	`, workspaceID)
+}
@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// schematicsTerraformState holds the parts of a Terraform state file that are
+// read by the data source. Version 4 states hold the outputs at the top level,
+// older states hold them in the root module.
+type schematicsTerraformState struct {
+	TerraformVersion string                           `json:"terraform_version"`
+	Serial           int64                            `json:"serial"`
+	Lineage          string                           `json:"lineage"`
+	Outputs          map[string]schematicsStateOutput `json:"outputs"`
+	Modules          []struct {
+		Path    []string                         `json:"path"`
+		Outputs map[string]schematicsStateOutput `json:"outputs"`
+	} `json:"modules"`
+}
+
+// decodeSchematicsTerraformState decodes a Terraform state file. The numbers
+// are decoded as json.Number to keep the precision of large numbers.
+func decodeSchematicsTerraformState(r io.Reader) (*schematicsTerraformState, error) {
+	var state schematicsTerraformState
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+type schematicsStateOutput struct {
+	Value     interface{}     `json:"value"`
+	Type      json.RawMessage `json:"type"`
+	Sensitive bool            `json:"sensitive"`
+}
+
+// schematicsStateOutputValue converts the value of a state output using the
+// type recorded in the state. The type of the value is inferred from the JSON
+// value when the state does not record the type, or when the type is dynamic.
+func schematicsStateOutputValue(output schematicsStateOutput) (attr.Value, diag.Diagnostics) {
+	if t, ok := schematicsStateType(output.Type); ok {
+		return schematicsStateTypedValue(t, output.Value)
+	}
+	return schematicsStateInferredValue(output.Value)
+}
+
+// schematicsStateType converts a type in the JSON representation used by
+// Terraform in the state, such as "string" or ["list", "number"], to the
+// matching attribute type. It returns false if the type is, or holds, the
+// dynamic pseudo-type, which can't be nested in a dynamic value.
+func schematicsStateType(raw json.RawMessage) (attr.Type, bool) {
+	if len(raw) == 0 {
+		return nil, false
+	}
+
+	var primitive string
+	if err := json.Unmarshal(raw, &primitive); err == nil {
+		switch primitive {
+		case "string":
+			return types.StringType, true
+		case "number":
+			return types.NumberType, true
+		case "bool":
+			return types.BoolType, true
+		}
+		return nil, false
+	}
+
+	var parts []json.RawMessage
+	if err := json.Unmarshal(raw, &parts); err != nil || len(parts) != 2 {
+		return nil, false
+	}
+	var kind string
+	if err := json.Unmarshal(parts[0], &kind); err != nil {
+		return nil, false
+	}
+
+	switch kind {
+	case "list", "set", "map":
+		elemType, ok := schematicsStateType(parts[1])
+		if !ok {
+			return nil, false
+		}
+		switch kind {
+		case "list":
+			return types.ListType{ElemType: elemType}, true
+		case "set":
+			return types.SetType{ElemType: elemType}, true
+		default:
+			return types.MapType{ElemType: elemType}, true
+		}
+	case "object":
+		var rawAttrTypes map[string]json.RawMessage
+		if err := json.Unmarshal(parts[1], &rawAttrTypes); err != nil {
+			return nil, false
+		}
+		attrTypes := make(map[string]attr.Type, len(rawAttrTypes))
+		for name, rawAttrType := range rawAttrTypes {
+			attrType, ok := schematicsStateType(rawAttrType)
+			if !ok {
+				return nil, false
+			}
+			attrTypes[name] = attrType
+		}
+		return types.ObjectType{AttrTypes: attrTypes}, true
+	case "tuple":
+		var rawElemTypes []json.RawMessage
+		if err := json.Unmarshal(parts[1], &rawElemTypes); err != nil {
+			return nil, false
+		}
+		elemTypes := make([]attr.Type, len(rawElemTypes))
+		for i, rawElemType := range rawElemTypes {
+			elemType, ok := schematicsStateType(rawElemType)
+			if !ok {
+				return nil, false
+			}
+			elemTypes[i] = elemType
+		}
+		return types.TupleType{ElemTypes: elemTypes}, true
+	}
+	return nil, false
+}
+
+// schematicsStateTypedValue converts a JSON value decoded with UseNumber to a
+// value of the attribute type.
+func schematicsStateTypedValue(t attr.Type, value interface{}) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	invalid := func() (attr.Value, diag.Diagnostics) {
+		diags.AddError("Invalid Output Value", fmt.Sprintf("the value %v is not a valid %s", value, t))
+		return nil, diags
+	}
+
+	switch t := t.(type) {
+	case types.ListType:
+		if value == nil {
+			return types.ListNull(t.ElemType), nil
+		}
+		elems, ok := schematicsStateTypedElements(t.ElemType, value, &diags)
+		if !ok {
+			return invalid()
+		}
+		return types.ListValue(t.ElemType, elems)
+	case types.SetType:
+		if value == nil {
+			return types.SetNull(t.ElemType), nil
+		}
+		elems, ok := schematicsStateTypedElements(t.ElemType, value, &diags)
+		if !ok {
+			return invalid()
+		}
+		return types.SetValue(t.ElemType, elems)
+	case types.MapType:
+		if value == nil {
+			return types.MapNull(t.ElemType), nil
+		}
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return invalid()
+		}
+		elems := make(map[string]attr.Value, len(object))
+		for key, elem := range object {
+			elemValue, elemDiags := schematicsStateTypedValue(t.ElemType, elem)
+			diags.Append(elemDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			elems[key] = elemValue
+		}
+		return types.MapValue(t.ElemType, elems)
+	case types.ObjectType:
+		if value == nil {
+			return types.ObjectNull(t.AttrTypes), nil
+		}
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return invalid()
+		}
+		attrValues := make(map[string]attr.Value, len(t.AttrTypes))
+		for name, attrType := range t.AttrTypes {
+			attrValue, attrDiags := schematicsStateTypedValue(attrType, object[name])
+			diags.Append(attrDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			attrValues[name] = attrValue
+		}
+		return types.ObjectValue(t.AttrTypes, attrValues)
+	case types.TupleType:
+		if value == nil {
+			return types.TupleNull(t.ElemTypes), nil
+		}
+		array, ok := value.([]interface{})
+		if !ok || len(array) != len(t.ElemTypes) {
+			return invalid()
+		}
+		elems := make([]attr.Value, len(array))
+		for i, elem := range array {
+			elemValue, elemDiags := schematicsStateTypedValue(t.ElemTypes[i], elem)
+			diags.Append(elemDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			elems[i] = elemValue
+		}
+		return types.TupleValue(t.ElemTypes, elems)
+	}
+
+	switch t {
+	case types.StringType:
+		if value == nil {
+			return types.StringNull(), nil
+		}
+		if s, ok := value.(string); ok {
+			return types.StringValue(s), nil
+		}
+	case types.NumberType:
+		if value == nil {
+			return types.NumberNull(), nil
+		}
+		if n, ok := value.(json.Number); ok {
+			if f, _, err := big.ParseFloat(string(n), 10, 512, big.ToNearestEven); err == nil {
+				return types.NumberValue(f), nil
+			}
+		}
+	case types.BoolType:
+		if value == nil {
+			return types.BoolNull(), nil
+		}
+		if b, ok := value.(bool); ok {
+			return types.BoolValue(b), nil
+		}
+	}
+	return invalid()
+}
+
+func schematicsStateTypedElements(elemType attr.Type, value interface{}, diags *diag.Diagnostics) ([]attr.Value, bool) {
+	array, ok := value.([]interface{})
+	if !ok {
+		return nil, false
+	}
+	elems := make([]attr.Value, len(array))
+	for i, elem := range array {
+		elemValue, elemDiags := schematicsStateTypedValue(elemType, elem)
+		diags.Append(elemDiags...)
+		if diags.HasError() {
+			return nil, false
+		}
+		elems[i] = elemValue
+	}
+	return elems, true
+}
+
+// schematicsStateInferredValue converts a JSON value decoded with UseNumber to
+// a value whose type is inferred from the JSON, like jsondecode does: arrays
+// become tuples and objects become objects. Null values become null strings,
+// since a dynamic value can't hold nested dynamic values.
+func schematicsStateInferredValue(value interface{}) (attr.Value, diag.Diagnostics) {
+	switch value := value.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(value), nil
+	case bool:
+		return types.BoolValue(value), nil
+	case json.Number:
+		return schematicsStateTypedValue(types.NumberType, value)
+	case []interface{}:
+		var diags diag.Diagnostics
+		elemTypes := make([]attr.Type, len(value))
+		elems := make([]attr.Value, len(value))
+		for i, elem := range value {
+			elemValue, elemDiags := schematicsStateInferredValue(elem)
+			diags.Append(elemDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			elemTypes[i] = elemValue.Type(context.Background())
+			elems[i] = elemValue
+		}
+		return types.TupleValue(elemTypes, elems)
+	case map[string]interface{}:
+		var diags diag.Diagnostics
+		attrTypes := make(map[string]attr.Type, len(value))
+		attrValues := make(map[string]attr.Value, len(value))
+		for name, attrValue := range value {
+			v, attrDiags := schematicsStateInferredValue(attrValue)
+			diags.Append(attrDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			attrTypes[name] = v.Type(context.Background())
+			attrValues[name] = v
+		}
+		return types.ObjectValue(attrTypes, attrValues)
+	}
+	var diags diag.Diagnostics
+	diags.AddError("Invalid Output Value", fmt.Sprintf("unsupported value %v of type %T", value, value))
+	return nil, diags
+}
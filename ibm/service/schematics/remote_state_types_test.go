@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeSchematicsStateOutputs(t *testing.T, state string) map[string]schematicsStateOutput {
+	decoded, err := decodeSchematicsTerraformState(strings.NewReader(state))
+	require.NoError(t, err)
+	return decoded.Outputs
+}
+
+// schematicsRecordedState is a state body returned by the state_store
+// endpoint of a workspace template.
+const schematicsRecordedState = `{
+	"version": 4,
+	"terraform_version": "1.5.7",
+	"serial": 12,
+	"lineage": "7b1a2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d",
+	"outputs": {
+		"vpc_id": {"value": "r006-0a1b2c3d", "type": "string"},
+		"crn_count": {"value": 12345678901234567890, "type": "number"},
+		"api_key": {"value": "secret", "type": "string", "sensitive": true}
+	},
+	"resources": []
+}`
+
+func TestSchematicsWorkspaceTemplateState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/workspaces/us-south.workspace.test/runtime_data/tmpl-1/state_store", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(schematicsRecordedState))
+	}))
+	defer server.Close()
+
+	client, err := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.NoError(t, err)
+
+	body, _, err := schematicsWorkspaceTemplateState(context.Background(), client, "us-south.workspace.test", "tmpl-1")
+	require.NoError(t, err)
+	defer body.Close()
+	state, err := decodeSchematicsTerraformState(body)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.5.7", state.TerraformVersion)
+	assert.Equal(t, int64(12), state.Serial)
+	assert.Equal(t, "7b1a2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d", state.Lineage)
+	require.Len(t, state.Outputs, 3)
+	assert.Equal(t, json.Number("12345678901234567890"), state.Outputs["crn_count"].Value)
+	assert.True(t, state.Outputs["api_key"].Sensitive)
+
+	value, diags := schematicsStateOutputValue(state.Outputs["vpc_id"])
+	require.False(t, diags.HasError())
+	assert.True(t, types.StringValue("r006-0a1b2c3d").Equal(value))
+}
+
+func TestSchematicsStateType(t *testing.T) {
+	cases := map[string]attr.Type{
+		`"string"`:                  types.StringType,
+		`"number"`:                  types.NumberType,
+		`"bool"`:                    types.BoolType,
+		`["list","string"]`:         types.ListType{ElemType: types.StringType},
+		`["set","number"]`:          types.SetType{ElemType: types.NumberType},
+		`["map",["list","bool"]]`:   types.MapType{ElemType: types.ListType{ElemType: types.BoolType}},
+		`["tuple",["string",true]]`: nil,
+		`["tuple",["string","bool"]]`: types.TupleType{ElemTypes: []attr.Type{
+			types.StringType, types.BoolType,
+		}},
+		`["object",{"a":"string","b":["list","number"]}]`: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"a": types.StringType,
+			"b": types.ListType{ElemType: types.NumberType},
+		}},
+		`"dynamic"`:                  nil,
+		`["list","dynamic"]`:         nil,
+		`["object",{"a":"dynamic"}]`: nil,
+		`"list"`:                     nil,
+		``:                           nil,
+	}
+	for raw, expected := range cases {
+		actual, ok := schematicsStateType(json.RawMessage(raw))
+		assert.Equal(t, expected != nil, ok, raw)
+		assert.Equal(t, expected, actual, raw)
+	}
+}
+
+func TestSchematicsStateOutputValue(t *testing.T) {
+	outputs := decodeSchematicsStateOutputs(t, `{
+		"version": 4,
+		"outputs": {
+			"name": {"value": "vpc", "type": "string"},
+			"count": {"value": 12345678901234567890, "type": "number"},
+			"zones": {"value": ["us-south-1", "us-south-2"], "type": ["list", "string"]},
+			"subnets": {"value": {"a": {"id": "s1", "size": 256}}, "type": ["map", ["object", {"id": "string", "size": "number"}]]},
+			"missing": {"value": null, "type": ["list", "string"]},
+			"any": {"value": {"ids": ["x", 1, null]}, "type": ["object", {"ids": "dynamic"}]}
+		}
+	}`)
+
+	value, diags := schematicsStateOutputValue(outputs["name"])
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("vpc"), value)
+
+	value, diags = schematicsStateOutputValue(outputs["count"])
+	require.False(t, diags.HasError())
+	expectedCount, _, _ := big.ParseFloat("12345678901234567890", 10, 512, big.ToNearestEven)
+	assert.True(t, types.NumberValue(expectedCount).Equal(value))
+
+	value, diags = schematicsStateOutputValue(outputs["zones"])
+	require.False(t, diags.HasError())
+	expectedZones, _ := types.ListValue(types.StringType, []attr.Value{types.StringValue("us-south-1"), types.StringValue("us-south-2")})
+	assert.True(t, expectedZones.Equal(value))
+
+	value, diags = schematicsStateOutputValue(outputs["subnets"])
+	require.False(t, diags.HasError())
+	subnetType := map[string]attr.Type{"id": types.StringType, "size": types.NumberType}
+	subnet, _ := types.ObjectValue(subnetType, map[string]attr.Value{
+		"id":   types.StringValue("s1"),
+		"size": types.NumberValue(big.NewFloat(256)),
+	})
+	expectedSubnets, _ := types.MapValue(types.ObjectType{AttrTypes: subnetType}, map[string]attr.Value{"a": subnet})
+	assert.True(t, expectedSubnets.Equal(value))
+
+	value, diags = schematicsStateOutputValue(outputs["missing"])
+	require.False(t, diags.HasError())
+	assert.True(t, types.ListNull(types.StringType).Equal(value))
+
+	value, diags = schematicsStateOutputValue(outputs["any"])
+	require.False(t, diags.HasError())
+	idsType := []attr.Type{types.StringType, types.NumberType, types.StringType}
+	ids, _ := types.TupleValue(idsType, []attr.Value{
+		types.StringValue("x"),
+		types.NumberValue(big.NewFloat(1)),
+		types.StringNull(),
+	})
+	expectedAny, _ := types.ObjectValue(map[string]attr.Type{"ids": types.TupleType{ElemTypes: idsType}}, map[string]attr.Value{"ids": ids})
+	assert.True(t, expectedAny.Equal(value))
+}
+
+func TestSchematicsStateOutputValueInvalid(t *testing.T) {
+	outputs := decodeSchematicsStateOutputs(t, `{
+		"outputs": {
+			"name": {"value": 1, "type": "string"},
+			"zones": {"value": "us-south-1", "type": ["list", "string"]},
+			"pair": {"value": ["a"], "type": ["tuple", ["string", "string"]]}
+		}
+	}`)
+	for name, output := range outputs {
+		_, diags := schematicsStateOutputValue(output)
+		assert.True(t, diags.HasError(), name)
+	}
+}
@@ -0,0 +1,424 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = &smSecretRotateAction{}
+	_ action.ActionWithConfigure      = &smSecretRotateAction{}
+	_ action.ActionWithValidateConfig = &smSecretRotateAction{}
+)
+
+// smSecretRotateTypes lists the secret types that can be rotated on demand.
+var smSecretRotateTypes = []string{
+	ArbitrarySecretType,
+	UsernamePasswordSecretType,
+	IAMCredentialsSecretType,
+	ImportedCertSecretType,
+	PrivateCertSecretType,
+	PublicCertSecretType,
+	ServiceCredentialsSecretType,
+}
+
+func NewSmSecretRotateAction() action.Action {
+	return &smSecretRotateAction{}
+}
+
+type smSecretRotateAction struct {
+	client        *secretsmanagerv2.SecretsManagerV2
+	endpointsFile string
+}
+
+type smSecretRotateModel struct {
+	InstanceID            types.String `tfsdk:"instance_id"`
+	Region                types.String `tfsdk:"region"`
+	EndpointType          types.String `tfsdk:"endpoint_type"`
+	SecretID              types.String `tfsdk:"secret_id"`
+	SecretType            types.String `tfsdk:"secret_type"`
+	Payload               types.String `tfsdk:"payload"`
+	Password              types.String `tfsdk:"password"`
+	Certificate           types.String `tfsdk:"certificate"`
+	Intermediate          types.String `tfsdk:"intermediate"`
+	PrivateKey            types.String `tfsdk:"private_key"`
+	Csr                   types.String `tfsdk:"csr"`
+	RotateKeys            types.Bool   `tfsdk:"rotate_keys"`
+	VersionCustomMetadata types.Map    `tfsdk:"version_custom_metadata"`
+	WaitTimeout           types.Int64  `tfsdk:"wait_timeout"`
+}
+
+func (a *smSecretRotateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_sm_secret_rotate"
+}
+
+func (a *smSecretRotateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a Secrets Manager secret on demand by creating a new secret version, independently of the rotation policy of the secret.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Secrets Manager instance.",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "The region of the Secrets Manager instance.",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "public or private.",
+			},
+			"secret_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the secret.",
+			},
+			"secret_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The secret type. Allowable values are: arbitrary, username_password, iam_credentials, imported_cert, private_cert, public_cert, service_credentials.",
+			},
+			"payload": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The new payload of an arbitrary secret. Required for arbitrary secrets. Can be set from an ephemeral value.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The new password of a username_password secret. A password is generated when it is not set. Can be set from an ephemeral value.",
+			},
+			"certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "The new PEM-encoded certificate of an imported certificate. Required for imported_cert secrets.",
+			},
+			"intermediate": schema.StringAttribute{
+				Optional:    true,
+				Description: "The new PEM-encoded intermediate certificate of an imported certificate.",
+			},
+			"private_key": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The new PEM-encoded private key of an imported certificate. Can be set from an ephemeral value.",
+			},
+			"csr": schema.StringAttribute{
+				Optional:    true,
+				Description: "The certificate signing request to sign the new version of a private certificate with.",
+			},
+			"rotate_keys": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether a new private key is generated for the new version of a public certificate.",
+			},
+			"version_custom_metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The custom metadata to set on the new secret version.",
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds to wait for a public certificate to be reissued. If not specified, defaults to 600 seconds (10 minutes).",
+			},
+		},
+	}
+}
+
+func (a *smSecretRotateAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config smSecretRotateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.EndpointType.IsNull() && !config.EndpointType.IsUnknown() {
+		if endpointType := config.EndpointType.ValueString(); endpointType != "public" && endpointType != "private" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint_type"),
+				"Invalid Endpoint Type",
+				fmt.Sprintf("endpoint_type must be public or private, got: %s", endpointType),
+			)
+		}
+	}
+
+	if config.SecretType.IsUnknown() {
+		return
+	}
+	secretType := config.SecretType.ValueString()
+	supported := false
+	for _, t := range smSecretRotateTypes {
+		supported = supported || t == secretType
+	}
+	if !supported {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secret_type"),
+			"Invalid Secret Type",
+			fmt.Sprintf("Secrets of type %s cannot be rotated by this action. Supported types are: %q", secretType, smSecretRotateTypes),
+		)
+		return
+	}
+
+	// Each type-specific argument may only be set for its own secret type.
+	typeArguments := map[string]struct {
+		secretType string
+		set        bool
+	}{
+		"payload":      {ArbitrarySecretType, !config.Payload.IsNull()},
+		"password":     {UsernamePasswordSecretType, !config.Password.IsNull()},
+		"certificate":  {ImportedCertSecretType, !config.Certificate.IsNull()},
+		"intermediate": {ImportedCertSecretType, !config.Intermediate.IsNull()},
+		"private_key":  {ImportedCertSecretType, !config.PrivateKey.IsNull()},
+		"csr":          {PrivateCertSecretType, !config.Csr.IsNull()},
+		"rotate_keys":  {PublicCertSecretType, !config.RotateKeys.IsNull()},
+		"wait_timeout": {PublicCertSecretType, !config.WaitTimeout.IsNull()},
+	}
+	for attribute, argument := range typeArguments {
+		if argument.set && argument.secretType != secretType {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s can only be set for %s secrets.", attribute, argument.secretType),
+			)
+		}
+	}
+
+	if secretType == ArbitrarySecretType && config.Payload.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("payload"),
+			"Missing Payload",
+			"payload must be set to rotate an arbitrary secret.",
+		)
+	}
+	if secretType == ImportedCertSecretType && config.Certificate.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate"),
+			"Missing Certificate",
+			"certificate must be set to rotate an imported certificate.",
+		)
+	}
+}
+
+func (a *smSecretRotateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, endpointsFile, err := getSecretsManagerSession(session)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Secrets Manager Client",
+			"An unexpected error occurred when creating the Secrets Manager client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Secrets Manager Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.client = client
+	a.endpointsFile = endpointsFile
+}
+
+func (a *smSecretRotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config smSecretRotateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Secrets Manager Client",
+			"The ibm_sm_secret_rotate action was invoked before the provider was configured.",
+		)
+		return
+	}
+
+	region := config.Region.ValueString()
+	if region == "" {
+		region = getRegionFromServiceURL(a.client)
+	}
+	endpointType := config.EndpointType.ValueString()
+	if endpointType == "" {
+		endpointType = getEndpointTypeFromServiceURL(a.client)
+	}
+	client := getClientWithInstanceEndpoint(a.client, config.InstanceID.ValueString(), region, endpointType, a.endpointsFile)
+
+	secretID := config.SecretID.ValueString()
+	secretType := config.SecretType.ValueString()
+
+	// Public certificates are reissued asynchronously, the new version only
+	// shows up once the certificate authority has issued it.
+	var versionsTotal int
+	if secretType == PublicCertSecretType {
+		certificate, err := getSmPublicCertificateMetadata(ctx, client, secretID)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Secret", err.Error())
+			return
+		}
+		versionsTotal = flex.IntValue(certificate.VersionsTotal)
+	}
+
+	versionPrototype := smSecretRotateVersionPrototype(config)
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretID)
+	createSecretVersionOptions.SetSecretVersionPrototype(versionPrototype)
+	version, response, err := client.CreateSecretVersionWithContext(ctx, createSecretVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
+		resp.Diagnostics.AddError(
+			"Unable to Rotate Secret",
+			fmt.Sprintf("ibm_sm_secret_rotate: CreateSecretVersionWithContext failed: %s", err),
+		)
+		return
+	}
+
+	versionID := "current"
+	if secretType == PublicCertSecretType {
+		waitTimeout := 600 * time.Second
+		if !config.WaitTimeout.IsNull() {
+			waitTimeout = time.Duration(config.WaitTimeout.ValueInt64()) * time.Second
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Certificate '%s' ordered, waiting for the new version (timeout: %v)...", secretID, waitTimeout),
+		})
+		if err := waitForSmPublicCertificateVersion(ctx, client, secretID, versionsTotal, waitTimeout); err != nil {
+			resp.Diagnostics.AddError("Certificate Not Reissued", err.Error())
+			return
+		}
+	} else if id := getSmModelID(version); id != "" {
+		versionID = id
+	}
+
+	if !config.VersionCustomMetadata.IsNull() {
+		versionCustomMetadata := map[string]string{}
+		resp.Diagnostics.Append(config.VersionCustomMetadata.ElementsAs(ctx, &versionCustomMetadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		secretVersionMetadataPatchModel := &secretsmanagerv2.SecretVersionMetadataPatch{
+			VersionCustomMetadata: map[string]interface{}{},
+		}
+		for k, v := range versionCustomMetadata {
+			secretVersionMetadataPatchModel.VersionCustomMetadata[k] = v
+		}
+		secretVersionMetadataPatchModelAsPatch, _ := secretVersionMetadataAsPatchFunction(secretVersionMetadataPatchModel)
+
+		updateSecretVersionOptions := &secretsmanagerv2.UpdateSecretVersionMetadataOptions{}
+		updateSecretVersionOptions.SetSecretID(secretID)
+		updateSecretVersionOptions.SetID(versionID)
+		updateSecretVersionOptions.SetSecretVersionMetadataPatch(secretVersionMetadataPatchModelAsPatch)
+		_, response, err := client.UpdateSecretVersionMetadataWithContext(ctx, updateSecretVersionOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response)
+			resp.Diagnostics.AddError(
+				"Unable to Update Secret Version Metadata",
+				fmt.Sprintf("ibm_sm_secret_rotate: UpdateSecretVersionMetadataWithContext failed: %s", err),
+			)
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Secret '%s' rotated successfully, new version: %s", secretID, versionID),
+	})
+}
+
+// smSecretRotateVersionPrototype builds the version prototype of the secret
+// type from the type-specific arguments.
+func smSecretRotateVersionPrototype(config smSecretRotateModel) secretsmanagerv2.SecretVersionPrototypeIntf {
+	switch config.SecretType.ValueString() {
+	case ArbitrarySecretType:
+		return &secretsmanagerv2.ArbitrarySecretVersionPrototype{
+			Payload: config.Payload.ValueStringPointer(),
+		}
+	case UsernamePasswordSecretType:
+		return &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{
+			Password: config.Password.ValueStringPointer(),
+		}
+	case IAMCredentialsSecretType:
+		return &secretsmanagerv2.IAMCredentialsSecretVersionPrototype{}
+	case ImportedCertSecretType:
+		return &secretsmanagerv2.ImportedCertificateVersionPrototype{
+			Certificate:  config.Certificate.ValueStringPointer(),
+			Intermediate: config.Intermediate.ValueStringPointer(),
+			PrivateKey:   config.PrivateKey.ValueStringPointer(),
+		}
+	case PrivateCertSecretType:
+		return &secretsmanagerv2.PrivateCertificateVersionPrototype{
+			Csr: config.Csr.ValueStringPointer(),
+		}
+	case PublicCertSecretType:
+		return &secretsmanagerv2.PublicCertificateVersionPrototype{
+			Rotation: &secretsmanagerv2.PublicCertificateRotationObject{
+				RotateKeys: core.BoolPtr(config.RotateKeys.ValueBool()),
+			},
+		}
+	default:
+		return &secretsmanagerv2.ServiceCredentialsSecretVersionPrototype{}
+	}
+}
+
+func getSmPublicCertificateMetadata(ctx context.Context, client *secretsmanagerv2.SecretsManagerV2, secretID string) (*secretsmanagerv2.PublicCertificateMetadata, error) {
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretID)
+	metadata, response, err := client.GetSecretMetadataWithContext(ctx, getSecretMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("ibm_sm_secret_rotate: GetSecretMetadataWithContext failed: %s", err)
+	}
+	certificate, ok := metadata.(*secretsmanagerv2.PublicCertificateMetadata)
+	if !ok {
+		return nil, fmt.Errorf("ibm_sm_secret_rotate: The secret %s is not a public certificate.", secretID)
+	}
+	return certificate, nil
+}
+
+// waitForSmPublicCertificateVersion waits until the public certificate has
+// more than versionsTotal versions, or its order failed.
+func waitForSmPublicCertificateVersion(ctx context.Context, client *secretsmanagerv2.SecretsManagerV2, secretID string, versionsTotal int, timeout time.Duration) error {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("operation cancelled: %w", ctx.Err())
+		case <-deadline:
+			return fmt.Errorf("timeout after %v waiting for the certificate %s to be reissued", timeout, secretID)
+		case <-ticker.C:
+		}
+
+		certificate, err := getSmPublicCertificateMetadata(ctx, client, secretID)
+		if err != nil {
+			log.Printf("[DEBUG] %s", err)
+			continue
+		}
+		if flex.IntValue(certificate.VersionsTotal) > versionsTotal {
+			return nil
+		}
+		if info := certificate.IssuanceInfo; info != nil && info.ErrorMessage != nil &&
+			core.StringNilMapper(info.StateDescription) == secretsmanagerv2.CertificateIssuanceInfo_StateDescription_Deactivated {
+			return fmt.Errorf("the order of the certificate %s failed: %s", secretID, *info.ErrorMessage)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+// TestAccIbmSmSecretRotateActionBasic rotates an arbitrary secret when the
+// rotation trigger changes and verifies a second version has been created.
+func TestAccIbmSmSecretRotateActionBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretRotateActionConfig("first rotation"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance", "secret_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretRotateActionConfig("second rotation"),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretRotateActionConfig("second rotation") + testAccCheckIbmSmSecretRotateActionVersionsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "total_count", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.ibm_sm_secret_versions.sm_secret_versions", "versions.*", map[string]string{
+						"alias":                              "current",
+						"version_custom_metadata.rotated_by": "terraform",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIbmSmSecretRotateActionInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: `
+					action "ibm_sm_secret_rotate" "rotate" {
+						config {
+							instance_id = "00000000-0000-0000-0000-000000000000"
+							secret_id   = "00000000-0000-0000-0000-000000000000"
+							secret_type = "arbitrary"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("Missing Payload"),
+			},
+			resource.TestStep{
+				Config: `
+					action "ibm_sm_secret_rotate" "rotate" {
+						config {
+							instance_id = "00000000-0000-0000-0000-000000000000"
+							secret_id   = "00000000-0000-0000-0000-000000000000"
+							secret_type = "iam_credentials"
+							payload     = "secret-credentials"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("payload can only be set for arbitrary secrets"),
+			},
+			resource.TestStep{
+				Config: `
+					action "ibm_sm_secret_rotate" "rotate" {
+						config {
+							instance_id = "00000000-0000-0000-0000-000000000000"
+							secret_id   = "00000000-0000-0000-0000-000000000000"
+							secret_type = "kv"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Secret Type"),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretRotateActionConfig(rotation string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			name = "test_arbitrary_secret_rotate_terraform"
+			instance_id   = "%s"
+			region        = "%s"
+			payload = "secret-credentials"
+			secret_group_id = "default"
+
+			lifecycle {
+				ignore_changes = [payload]
+			}
+		}
+
+		action "ibm_sm_secret_rotate" "rotate" {
+			config {
+				instance_id = "%s"
+				region      = "%s"
+				secret_id   = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+				secret_type = "arbitrary"
+				payload     = "rotated-secret-credentials"
+				version_custom_metadata = {
+					rotated_by = "terraform"
+				}
+			}
+		}
+
+		resource "terraform_data" "rotation" {
+			input = "%s"
+
+			lifecycle {
+				action_trigger {
+					events  = [after_update]
+					actions = [action.ibm_sm_secret_rotate.rotate]
+				}
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, rotation)
+}
+
+func testAccCheckIbmSmSecretRotateActionVersionsConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_sm_secret_versions" "sm_secret_versions" {
+			instance_id = "%s"
+			region      = "%s"
+			secret_id   = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
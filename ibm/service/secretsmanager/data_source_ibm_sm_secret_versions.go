@@ -0,0 +1,297 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func DataSourceIbmSmSecretVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"secret_id", "name"},
+				Description:  "The ID of the secret.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"secret_id", "name"},
+				RequiredWith: []string{"secret_group_name", "secret_type"},
+				Description:  "The human-readable name of your secret.",
+			},
+			"secret_group_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"name"},
+				Description:  "The human-readable name of your secret group.",
+			},
+			"secret_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{ArbitrarySecretType, UsernamePasswordSecretType, CustomCredentialsSecretType, IAMCredentialsSecretType, ServiceCredentialsSecretType, KvSecretType, ImportedCertSecretType, PublicCertSecretType, PrivateCertSecretType}, false),
+				Description:  "The secret type. Required to look up the secret by name.",
+			},
+			"total_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of resources in a collection.",
+			},
+			"versions": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A collection of secret version metadata.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A UUID identifier.",
+						},
+						"alias": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A human-readable alias that describes the secret version. 'Current' is used for version `n` and 'previous' is used for version `n-1`.",
+						},
+						"auto_rotated": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the version of the secret was created by automatic rotation.",
+						},
+						"created_by": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier that is associated with the entity that created the secret.",
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when a resource was created. The date format follows RFC 3339.",
+						},
+						"downloaded": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the secret data that is associated with a secret version was retrieved in a call to the service API.",
+						},
+						"payload_available": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the secret payload is available in this secret version.",
+						},
+						"expiration_date": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date a secret is expired. The date format follows RFC 3339.",
+						},
+						"serial_number": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique serial number that was assigned to a certificate by the issuing certificate authority.",
+						},
+						"version_custom_metadata": &schema.Schema{
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The secret version metadata that a user can customize.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretVersionsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+
+	secretId := d.Get("secret_id").(string)
+	if secretId == "" {
+		secretId, err = getSmSecretIdByName(context, secretsManagerClient, d.Get("name").(string), d.Get("secret_group_name").(string), d.Get("secret_type").(string))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+			return tfErr.GetDiag()
+		}
+	}
+
+	listSecretVersionsOptions := &secretsmanagerv2.ListSecretVersionsOptions{}
+	listSecretVersionsOptions.SetSecretID(secretId)
+
+	secretVersionMetadataCollection, response, err := secretsManagerClient.ListSecretVersionsWithContext(context, listSecretVersionsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListSecretVersionsWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListSecretVersionsWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+
+	secretType := d.Get("secret_type").(string)
+	mapSlice := []map[string]interface{}{}
+	for _, modelItem := range secretVersionMetadataCollection.Versions {
+		model, err := dataSourceIbmSmSecretVersionsSecretVersionMetadata(modelItem)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+			return tfErr.GetDiag()
+		}
+		if model.SecretType != nil {
+			secretType = *model.SecretType
+		}
+		mapSlice = append(mapSlice, dataSourceIbmSmSecretVersionsSecretVersionMetadataToMap(model))
+	}
+
+	if err = d.Set("region", region); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting region"), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_id", secretId); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting secret_id"), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_type", secretType); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting secret_type"), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("versions", mapSlice); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting versions"), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("total_count", flex.IntValue(secretVersionMetadataCollection.TotalCount)); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting total_count"), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
+
+// getSmSecretIdByName returns the ID of the secret with the name and type in
+// the secret group. It only reads the metadata of the secrets: reading the
+// secret by name would retrieve its payload and mark the current version as
+// downloaded.
+func getSmSecretIdByName(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, name, groupName, secretType string) (string, error) {
+	secretGroupCollection, response, err := secretsManagerClient.ListSecretGroupsWithContext(context, &secretsmanagerv2.ListSecretGroupsOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] ListSecretGroupsWithContext failed %s\n%s", err, response)
+		return "", fmt.Errorf("ListSecretGroupsWithContext failed %s\n%s", err, response)
+	}
+	groupId := ""
+	for _, group := range secretGroupCollection.SecretGroups {
+		if group.Name != nil && *group.Name == groupName && group.ID != nil {
+			groupId = *group.ID
+		}
+	}
+	if groupId == "" {
+		if groupName != "default" {
+			return "", fmt.Errorf("Secret group %q was not found", groupName)
+		}
+		groupId = "default"
+	}
+
+	listSecretsOptions := &secretsmanagerv2.ListSecretsOptions{}
+	listSecretsOptions.SetSearch(name)
+	listSecretsOptions.SetGroups([]string{groupId})
+	listSecretsOptions.SetSecretTypes([]string{secretType})
+	pager, err := secretsManagerClient.NewSecretsPager(listSecretsOptions)
+	if err != nil {
+		return "", err
+	}
+	allItems, err := pager.GetAllWithContext(context)
+	if err != nil {
+		log.Printf("[DEBUG] SecretsPager.GetAll() failed %s", err)
+		return "", fmt.Errorf("SecretsPager.GetAll() failed %s", err)
+	}
+
+	// The search also matches other fields and partial names
+	for _, secret := range allItems {
+		var metadata struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		}
+		jsonData, err := json.Marshal(secret)
+		if err != nil {
+			return "", err
+		}
+		if err = json.Unmarshal(jsonData, &metadata); err != nil {
+			return "", err
+		}
+		if metadata.Name == name {
+			return metadata.ID, nil
+		}
+	}
+	return "", fmt.Errorf("Secret %q of type %s was not found in secret group %q", name, secretType, groupName)
+}
+
+// dataSourceIbmSmSecretVersionsSecretVersionMetadata converts the version
+// metadata of any secret type to the generic SecretVersionMetadata model,
+// which holds the fields of all the types.
+func dataSourceIbmSmSecretVersionsSecretVersionMetadata(modelIntf secretsmanagerv2.SecretVersionMetadataIntf) (*secretsmanagerv2.SecretVersionMetadata, error) {
+	jsonData, err := json.Marshal(modelIntf)
+	if err != nil {
+		return nil, err
+	}
+	model := &secretsmanagerv2.SecretVersionMetadata{}
+	if err = json.Unmarshal(jsonData, model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+func dataSourceIbmSmSecretVersionsSecretVersionMetadataToMap(model *secretsmanagerv2.SecretVersionMetadata) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	if model.ID != nil {
+		modelMap["id"] = model.ID
+	}
+	if model.Alias != nil {
+		modelMap["alias"] = model.Alias
+	}
+	if model.AutoRotated != nil {
+		modelMap["auto_rotated"] = model.AutoRotated
+	}
+	if model.CreatedBy != nil {
+		modelMap["created_by"] = model.CreatedBy
+	}
+	if model.CreatedAt != nil {
+		modelMap["created_at"] = DateTimeToRFC3339(model.CreatedAt)
+	}
+	if model.Downloaded != nil {
+		modelMap["downloaded"] = model.Downloaded
+	}
+	if model.PayloadAvailable != nil {
+		modelMap["payload_available"] = model.PayloadAvailable
+	}
+	if model.ExpirationDate != nil {
+		modelMap["expiration_date"] = DateTimeToRFC3339(model.ExpirationDate)
+	}
+	if model.SerialNumber != nil {
+		modelMap["serial_number"] = model.SerialNumber
+	}
+	if model.VersionCustomMetadata != nil {
+		modelMap["version_custom_metadata"] = flex.Flatten(model.VersionCustomMetadata)
+	}
+	return modelMap
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretVersionsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretVersionsDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_sm_secret_versions.sm_secret_versions", "id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "secret_type", "arbitrary"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "versions.0.payload_available", "true"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "versions.0.alias", "current"),
+					resource.TestCheckResourceAttrPair("data.ibm_sm_secret_versions.sm_secret_versions_by_name", "secret_id", "ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance", "secret_id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions_by_name", "total_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretVersionsDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			name = "test_arbitrary_secret_versions_terraform"
+			instance_id   = "%s"
+			region        = "%s"
+			payload = "secret-credentials"
+			secret_group_id = "default"
+		}
+
+		data "ibm_sm_secret_versions" "sm_secret_versions" {
+			instance_id   = "%s"
+			region = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+		}
+
+		data "ibm_sm_secret_versions" "sm_secret_versions_by_name" {
+			instance_id   = "%s"
+			region = "%s"
+			name = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.name
+			secret_group_name = "default"
+			secret_type = "arbitrary"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &smArbitrarySecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &smArbitrarySecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smArbitrarySecretEphemeralResource{}
+)
+
+func NewSmArbitrarySecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smArbitrarySecretEphemeralResource{}
+}
+
+type smArbitrarySecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+type smArbitrarySecretEphemeralModel struct {
+	smSecretEphemeralModel
+	Payload types.String `tfsdk:"payload"`
+}
+
+func (r *smArbitrarySecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = ArbitrarySecretResourceName
+}
+
+func (r *smArbitrarySecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the payload of an arbitrary secret without persisting it to the Terraform plan or state.",
+		Attributes: smSecretEphemeralAttributes(map[string]schema.Attribute{
+			"payload": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The arbitrary secret's data payload.",
+			},
+		}),
+	}
+}
+
+func (r *smArbitrarySecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	validateSmSecretLookup(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (r *smArbitrarySecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data smArbitrarySecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret := r.getSecret(ctx, &data.smSecretEphemeralModel, ArbitrarySecretType, ArbitrarySecretResourceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arbitrarySecret, ok := secret.(*secretsmanagerv2.ArbitrarySecret)
+	if !ok {
+		wrongSmSecretTypeError(&resp.Diagnostics, "an Arbitrary", ArbitrarySecretResourceName)
+		return
+	}
+
+	data.setCommon(arbitrarySecret.ID, arbitrarySecret.Name, arbitrarySecret.SecretGroupID, arbitrarySecret.Crn, DateTimeToRFC3339(arbitrarySecret.ExpirationDate))
+	data.Payload = types.StringPointerValue(arbitrarySecret.Payload)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
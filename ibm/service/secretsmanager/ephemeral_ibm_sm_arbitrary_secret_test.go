@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmArbitrarySecretEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmArbitrarySecretEphemeralResourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance", "secret_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIbmSmArbitrarySecretEphemeralResourceInvalidLookup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckIbmSmArbitrarySecretEphemeralResourceConfigInvalidLookup(),
+				ExpectError: regexp.MustCompile("Exactly one of \"secret_id\" or \"name\" must be specified"),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmArbitrarySecretEphemeralResourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			name = "test_arbitrary_secret_ephemeral_terraform"
+			instance_id   = "%s"
+			region        = "%s"
+			payload = "secret-credentials"
+			secret_group_id = "default"
+		}
+
+		ephemeral "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			instance_id   = "%s"
+			region = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+		}
+
+		ephemeral "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_by_name" {
+			instance_id   = "%s"
+			region = "%s"
+			name = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.name
+			secret_group_name = "default"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
+
+func testAccCheckIbmSmArbitrarySecretEphemeralResourceConfigInvalidLookup() string {
+	return fmt.Sprintf(`
+		ephemeral "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			instance_id   = "%s"
+			region = "%s"
+			secret_id = "00000000-0000-0000-0000-000000000000"
+			name = "test_arbitrary_secret_ephemeral_terraform"
+			secret_group_name = "default"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &smIAMCredentialsSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &smIAMCredentialsSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smIAMCredentialsSecretEphemeralResource{}
+)
+
+func NewSmIAMCredentialsSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smIAMCredentialsSecretEphemeralResource{}
+}
+
+type smIAMCredentialsSecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+type smIAMCredentialsSecretEphemeralModel struct {
+	smSecretEphemeralModel
+	ApiKey    types.String `tfsdk:"api_key"`
+	ApiKeyID  types.String `tfsdk:"api_key_id"`
+	ServiceID types.String `tfsdk:"service_id"`
+}
+
+func (r *smIAMCredentialsSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = IAMCredentialsSecretResourceName
+}
+
+func (r *smIAMCredentialsSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the API key of an IAM credentials secret without persisting it to the Terraform plan or state.",
+		Attributes: smSecretEphemeralAttributes(map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for this secret.After the secret reaches the end of its lease (see the `ttl` field), the API key is deleted automatically.",
+			},
+			"api_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the API key that is generated for this secret.",
+			},
+			"service_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The service ID under which the API key is created.",
+			},
+		}),
+	}
+}
+
+func (r *smIAMCredentialsSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	validateSmSecretLookup(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (r *smIAMCredentialsSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data smIAMCredentialsSecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret := r.getSecret(ctx, &data.smSecretEphemeralModel, IAMCredentialsSecretType, IAMCredentialsSecretResourceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iamCredentialsSecret, ok := secret.(*secretsmanagerv2.IAMCredentialsSecret)
+	if !ok {
+		wrongSmSecretTypeError(&resp.Diagnostics, "an IAM credentials", IAMCredentialsSecretResourceName)
+		return
+	}
+
+	data.setCommon(iamCredentialsSecret.ID, iamCredentialsSecret.Name, iamCredentialsSecret.SecretGroupID, iamCredentialsSecret.Crn, DateTimeToRFC3339(iamCredentialsSecret.ExpirationDate))
+	data.ApiKey = types.StringPointerValue(iamCredentialsSecret.ApiKey)
+	data.ApiKeyID = types.StringPointerValue(iamCredentialsSecret.ApiKeyID)
+	data.ServiceID = types.StringPointerValue(iamCredentialsSecret.ServiceID)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
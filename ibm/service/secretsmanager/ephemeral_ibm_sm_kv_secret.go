@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &smKvSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &smKvSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smKvSecretEphemeralResource{}
+)
+
+func NewSmKvSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smKvSecretEphemeralResource{}
+}
+
+type smKvSecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+type smKvSecretEphemeralModel struct {
+	smSecretEphemeralModel
+	Data types.Map `tfsdk:"data"`
+}
+
+func (r *smKvSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = KvSecretResourceName
+}
+
+func (r *smKvSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the data of a key-value secret without persisting it to the Terraform plan or state.",
+		Attributes: smSecretEphemeralAttributes(map[string]schema.Attribute{
+			"data": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "The payload data of a key-value secret.",
+			},
+		}),
+	}
+}
+
+func (r *smKvSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	validateSmSecretLookup(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (r *smKvSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data smKvSecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret := r.getSecret(ctx, &data.smSecretEphemeralModel, KvSecretType, KvSecretResourceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kvSecret, ok := secret.(*secretsmanagerv2.KVSecret)
+	if !ok {
+		wrongSmSecretTypeError(&resp.Diagnostics, "a Key Value", KvSecretResourceName)
+		return
+	}
+
+	data.setCommon(kvSecret.ID, kvSecret.Name, kvSecret.SecretGroupID, kvSecret.Crn, "")
+	kvData, diags := types.MapValueFrom(ctx, types.StringType, flex.Flatten(kvSecret.Data))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Data = kvData
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
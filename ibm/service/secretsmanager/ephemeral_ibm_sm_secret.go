@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// smSecretEphemeralResource holds the logic shared by the Secrets Manager
+// ephemeral resources. Each secret type embeds it and only describes its own
+// payload attributes, so secret values are read at apply time and never
+// persisted to the plan or state.
+type smSecretEphemeralResource struct {
+	client        *secretsmanagerv2.SecretsManagerV2
+	endpointsFile string
+}
+
+// smSecretEphemeralModel describes the attributes common to every Secrets
+// Manager ephemeral resource.
+type smSecretEphemeralModel struct {
+	InstanceID      types.String `tfsdk:"instance_id"`
+	Region          types.String `tfsdk:"region"`
+	EndpointType    types.String `tfsdk:"endpoint_type"`
+	SecretID        types.String `tfsdk:"secret_id"`
+	Name            types.String `tfsdk:"name"`
+	SecretGroupName types.String `tfsdk:"secret_group_name"`
+	SecretGroupID   types.String `tfsdk:"secret_group_id"`
+	Crn             types.String `tfsdk:"crn"`
+	ExpirationDate  types.String `tfsdk:"expiration_date"`
+}
+
+// smSecretEphemeralAttributes returns the common attributes merged with the
+// payload attributes of a specific secret type.
+func smSecretEphemeralAttributes(payloadAttributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"instance_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the Secrets Manager instance.",
+		},
+		"region": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The region of the Secrets Manager instance.",
+		},
+		"endpoint_type": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "public or private.",
+		},
+		"secret_id": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the secret. Exactly one of secret_id or name must be specified.",
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The human-readable name of your secret. Requires secret_group_name.",
+		},
+		"secret_group_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The human-readable name of your secret group. Requires name.",
+		},
+		"secret_group_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "A UUID identifier, or `default` secret group.",
+		},
+		"crn": schema.StringAttribute{
+			Computed:    true,
+			Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+		},
+		"expiration_date": schema.StringAttribute{
+			Computed:    true,
+			Description: "The date a secret is expired. The date format follows RFC 3339.",
+		},
+	}
+	for name, attribute := range payloadAttributes {
+		attributes[name] = attribute
+	}
+	return attributes
+}
+
+func (r *smSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, endpointsFile, err := getSecretsManagerSession(session)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Secrets Manager Client",
+			"An unexpected error occurred when creating the Secrets Manager client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Secrets Manager Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	r.client = client
+	r.endpointsFile = endpointsFile
+}
+
+// validateSmSecretLookup mirrors the ExactlyOneOf and RequiredWith rules of the
+// sm_*_secret data sources.
+func validateSmSecretLookup(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var secretID, name, groupName types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("secret_id"), &secretID)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("name"), &name)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("secret_group_name"), &groupName)...)
+	if diags.HasError() {
+		return
+	}
+
+	if secretID.IsUnknown() || name.IsUnknown() || groupName.IsUnknown() {
+		return
+	}
+
+	if secretID.IsNull() == name.IsNull() {
+		diags.AddAttributeError(
+			path.Root("secret_id"),
+			"Invalid Attribute Combination",
+			"Exactly one of \"secret_id\" or \"name\" must be specified.",
+		)
+	}
+	if name.IsNull() != groupName.IsNull() {
+		diags.AddAttributeError(
+			path.Root("secret_group_name"),
+			"Invalid Attribute Combination",
+			"\"name\" and \"secret_group_name\" must be specified together.",
+		)
+	}
+}
+
+// getSecret fetches the secret by ID, or by name and secret group, from the
+// instance endpoint. The resolved region and endpoint type are written back to
+// the model.
+func (r *smSecretEphemeralResource) getSecret(ctx context.Context, model *smSecretEphemeralModel, secretType string, resourceName string, diags *diag.Diagnostics) secretsmanagerv2.SecretIntf {
+	if r.client == nil {
+		diags.AddError(
+			"Unconfigured Secrets Manager Client",
+			fmt.Sprintf("The %s ephemeral resource was opened before the provider was configured.", resourceName),
+		)
+		return nil
+	}
+
+	region := model.Region.ValueString()
+	if region == "" {
+		region = getRegionFromServiceURL(r.client)
+	}
+	endpointType := model.EndpointType.ValueString()
+	if endpointType == "" {
+		endpointType = getEndpointTypeFromServiceURL(r.client)
+	}
+	model.Region = types.StringValue(region)
+	model.EndpointType = types.StringValue(endpointType)
+
+	client := getClientWithInstanceEndpoint(r.client, model.InstanceID.ValueString(), region, endpointType, r.endpointsFile)
+
+	var secretIntf secretsmanagerv2.SecretIntf
+	var err error
+	if !model.SecretID.IsNull() {
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+		getSecretOptions.SetID(model.SecretID.ValueString())
+
+		secretIntf, _, err = client.GetSecretWithContext(ctx, getSecretOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetSecretWithContext failed %s", err)
+			diags.AddError(
+				"Unable to Read Secret",
+				fmt.Sprintf("(Ephemeral) %s: GetSecretWithContext failed: %s", resourceName, err.Error()),
+			)
+			return nil
+		}
+	} else {
+		getSecretByNameOptions := &secretsmanagerv2.GetSecretByNameTypeOptions{}
+		getSecretByNameOptions.SetName(model.Name.ValueString())
+		getSecretByNameOptions.SetSecretType(secretType)
+		getSecretByNameOptions.SetSecretGroupName(model.SecretGroupName.ValueString())
+
+		secretIntf, _, err = client.GetSecretByNameTypeWithContext(ctx, getSecretByNameOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetSecretByNameTypeWithContext failed %s", err)
+			diags.AddError(
+				"Unable to Read Secret",
+				fmt.Sprintf("(Ephemeral) %s: GetSecretByNameTypeWithContext failed: %s", resourceName, err.Error()),
+			)
+			return nil
+		}
+	}
+
+	return secretIntf
+}
+
+// setCommon copies the metadata shared by all secret types into the model.
+func (model *smSecretEphemeralModel) setCommon(id, name, secretGroupID, crn *string, expirationDate string) {
+	model.SecretID = types.StringPointerValue(id)
+	model.Name = types.StringPointerValue(name)
+	model.SecretGroupID = types.StringPointerValue(secretGroupID)
+	model.Crn = types.StringPointerValue(crn)
+	model.ExpirationDate = types.StringValue(expirationDate)
+}
+
+func wrongSmSecretTypeError(diags *diag.Diagnostics, description string, resourceName string) {
+	diags.AddError(
+		"Wrong Secret Type",
+		fmt.Sprintf("(Ephemeral) %s: The provided secret is not %s secret.", resourceName, description),
+	)
+}
@@ -53,9 +53,10 @@ const (
 	PublicCertConfigDnsClassicInfrastructureResourceName = "ibm_sm_public_certificate_configuration_dns_classic_infrastructure"
 	PublicCertConfigActionValidateManualDNSResourceName  = "ibm_sm_public_certificate_action_validate_manual_dns"
 
-	SecretGroupResourceName  = "ibm_sm_secret_group"
-	SecretGroupsResourceName = "ibm_sm_secret_groups"
-	SecretsResourceName      = "ibm_sm_secrets"
+	SecretGroupResourceName    = "ibm_sm_secret_group"
+	SecretGroupsResourceName   = "ibm_sm_secret_groups"
+	SecretsResourceName        = "ibm_sm_secrets"
+	SecretVersionsResourceName = "ibm_sm_secret_versions"
 )
 
 func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
@@ -63,27 +64,36 @@ func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.Reso
 	if ok {
 		return d.Get("region").(string)
 	} else {
-		// extract region from base URL (provider config)
-		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
-		baseUrl := originalClient.Service.GetServiceURL()
-		u := strings.Replace(baseUrl, "private.", "", 1)
-		return strings.Split(u, ".")[1]
+		return getRegionFromServiceURL(originalClient)
 	}
 }
 
+// Extract the region from the base URL of the provider's Secrets Manager client
+func getRegionFromServiceURL(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
+	baseUrl := originalClient.Service.GetServiceURL()
+	u := strings.Replace(baseUrl, "private.", "", 1)
+	return strings.Split(u, ".")[1]
+}
+
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
 	_, ok := d.GetOk("endpoint_type")
 	if ok {
 		return d.Get("endpoint_type").(string)
 	} else {
-		baseUrl := originalClient.Service.GetServiceURL()
+		return getEndpointTypeFromServiceURL(originalClient)
+	}
+}
 
-		if strings.Contains(baseUrl, "private.") {
-			return "private"
-		} else {
-			return "public"
-		}
+// Derive the endpoint type from the base URL of the provider's Secrets Manager client
+func getEndpointTypeFromServiceURL(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	baseUrl := originalClient.Service.GetServiceURL()
+
+	if strings.Contains(baseUrl, "private.") {
+		return "private"
+	} else {
+		return "public"
 	}
 }
 
@@ -111,7 +121,11 @@ func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManag
 	// Check if we're running in the staging environment based on the configuration of the IAM API endpoint
 	iamUrl := os.Getenv("IBMCLOUD_IAM_API_ENDPOINT")
 	if iamUrl == "" {
-		iamUrl = conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
+		var err error
+		iamUrl, err = conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
+		if err != nil {
+			log.Printf("[ERROR] %s", err)
+		}
 	}
 
 	if strings.Contains(iamUrl, "test") {
@@ -274,3 +288,16 @@ func secretVersionMetadataAsPatchFunction(secretVersionMetadataPatch *secretsman
 	}
 	return
 }
+
+// getSmModelID returns the ID of a secret or secret version model, whatever
+// its secret type.
+func getSmModelID(model interface{}) string {
+	var idModel struct {
+		ID string `json:"id"`
+	}
+	jsonData, err := json.Marshal(model)
+	if err == nil {
+		json.Unmarshal(jsonData, &idModel)
+	}
+	return idModel.ID
+}
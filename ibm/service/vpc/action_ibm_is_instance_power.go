@@ -0,0 +1,330 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = &isInstancePowerAction{}
+	_ action.ActionWithConfigure      = &isInstancePowerAction{}
+	_ action.ActionWithValidateConfig = &isInstancePowerAction{}
+)
+
+func NewISInstancePowerAction() action.Action {
+	return &isInstancePowerAction{}
+}
+
+type isInstancePowerAction struct {
+	client *vpcv1.VpcV1
+}
+
+type isInstancePowerModel struct {
+	Instance    types.String `tfsdk:"instance"`
+	Action      types.String `tfsdk:"action"`
+	Force       types.Bool   `tfsdk:"force"`
+	WaitTimeout types.Int64  `tfsdk:"wait_timeout"`
+}
+
+var isInstancePowerActions = []string{"start", "stop", "reboot"}
+
+func (a *isInstancePowerAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_is_instance_power"
+}
+
+func (a *isInstancePowerAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts, stops or reboots a VPC virtual server instance and waits until the instance reaches the target status. Unlike the ibm_is_instance_action resource, nothing is stored in the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"instance": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the virtual server instance.",
+			},
+			"action": schema.StringAttribute{
+				Required:    true,
+				Description: "The power action to perform. Allowable values are: start, stop, reboot.",
+			},
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true, the action will be forced immediately, and all queued actions deleted. Ignored for the start action. Default: false",
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds to wait for the instance to reach the target status. If not specified, defaults to 600 seconds (10 minutes).",
+			},
+		},
+	}
+}
+
+func (a *isInstancePowerAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config isInstancePowerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Action.IsNull() || config.Action.IsUnknown() {
+		return
+	}
+	for _, allowed := range isInstancePowerActions {
+		if config.Action.ValueString() == allowed {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("action"),
+		"Invalid Power Action",
+		fmt.Sprintf("The action must be one of %s, got: %s", strings.Join(isInstancePowerActions, ", "), config.Action.ValueString()),
+	)
+}
+
+func (a *isInstancePowerAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := vpcClient(session)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create VPC Client",
+			"An unexpected error occurred when creating the VPC client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"VPC Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *isInstancePowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config isInstancePowerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Provider Session",
+			"The ibm_is_instance_power action was invoked before the provider was configured.",
+		)
+		return
+	}
+
+	waitTimeout := 600 * time.Second
+	if !config.WaitTimeout.IsNull() {
+		waitTimeout = time.Duration(config.WaitTimeout.ValueInt64()) * time.Second
+	}
+
+	instanceID := config.Instance.ValueString()
+	actionType := config.Action.ValueString()
+
+	instance, response, err := a.client.GetInstanceWithContext(ctx, &vpcv1.GetInstanceOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		a.handleError(response, err, "retrieve the instance", resp)
+		return
+	}
+
+	targetStatus := isInstanceStatusRunning
+	if actionType == "stop" {
+		targetStatus = isInstanceActionStatusStopped
+	}
+
+	if instance.Status == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Instance Status",
+			fmt.Sprintf("The status of instance '%s' was not returned by the API.", instanceID),
+		)
+		return
+	}
+	currentStatus := *instance.Status
+	switch {
+	case actionType != "reboot" && currentStatus == targetStatus:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Instance '%s' is already %s, nothing to do", instanceID, currentStatus),
+		})
+		return
+	case (actionType == "stop" || actionType == "reboot") && currentStatus != isInstanceStatusRunning:
+		resp.Diagnostics.AddError(
+			"Invalid Instance Status",
+			fmt.Sprintf("Cannot %s instance '%s' while it is %s, the instance must be %s.", actionType, instanceID, currentStatus, isInstanceStatusRunning),
+		)
+		return
+	case actionType == "start" && currentStatus != isInstanceActionStatusStopped:
+		resp.Diagnostics.AddError(
+			"Invalid Instance Status",
+			fmt.Sprintf("Cannot start instance '%s' while it is %s, the instance must be %s.", instanceID, currentStatus, isInstanceActionStatusStopped),
+		)
+		return
+	}
+
+	createOptions := &vpcv1.CreateInstanceActionOptions{
+		InstanceID: &instanceID,
+		Type:       &actionType,
+	}
+	if !config.Force.IsNull() && actionType != "start" {
+		createOptions.Force = config.Force.ValueBoolPointer()
+	}
+
+	_, response, err = a.client.CreateInstanceActionWithContext(ctx, createOptions)
+	if err != nil {
+		a.handleError(response, err, fmt.Sprintf("%s the instance", actionType), resp)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Requested %s of instance '%s', waiting for status '%s' (timeout: %v)...", actionType, instanceID, targetStatus, waitTimeout),
+	})
+
+	// A reboot ends in the status the instance had before the action, so wait
+	// for the instance to leave that status before waiting for it to return.
+	err = a.waitForStatus(ctx, instanceID, targetStatus, actionType == "reboot", waitTimeout, resp.SendProgress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Instance Power Action Failed",
+			fmt.Sprintf("Instance '%s' did not reach status '%s' after %s: %s", instanceID, targetStatus, actionType, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Instance '%s' is %s", instanceID, targetStatus),
+	})
+}
+
+// waitForStatus polls the instance until it reaches the target status. If
+// leaveTarget is set, the instance must first be seen outside the target
+// status, so a reboot is not reported as done before it has started.
+func (a *isInstancePowerAction) waitForStatus(ctx context.Context, instanceID, targetStatus string, leaveTarget bool, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) error {
+	deadline := time.Now().Add(timeout)
+	pollInterval := 10 * time.Second
+	maxInterval := 30 * time.Second
+	backoffMultiplier := 1.5
+	lastStatus := ""
+
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("operation cancelled: %w", ctx.Err())
+		case <-time.After(pollInterval):
+		}
+
+		instance, response, err := a.client.GetInstanceWithContext(ctx, &vpcv1.GetInstanceOptions{
+			ID: &instanceID,
+		})
+		if err != nil {
+			if isRetryableInstancePowerError(response) {
+				continue
+			}
+			return fmt.Errorf("failed to get instance status: %w", err)
+		}
+
+		if instance.Status != nil {
+			currentStatus := *instance.Status
+			if currentStatus != lastStatus {
+				sendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Instance status: %s", currentStatus),
+				})
+				lastStatus = currentStatus
+			}
+
+			switch currentStatus {
+			case targetStatus:
+				if !leaveTarget {
+					return nil
+				}
+			case isInstanceStatusFailed:
+				reason := "unknown error"
+				if len(instance.StatusReasons) > 0 && instance.StatusReasons[0].Message != nil {
+					reason = *instance.StatusReasons[0].Message
+				}
+				return fmt.Errorf("instance failed: %s", reason)
+			default:
+				leaveTarget = false
+			}
+		}
+
+		pollInterval = time.Duration(float64(pollInterval) * backoffMultiplier)
+		if pollInterval > maxInterval {
+			pollInterval = maxInterval
+		}
+	}
+
+	return fmt.Errorf("timeout after %v", timeout)
+}
+
+func isRetryableInstancePowerError(response *core.DetailedResponse) bool {
+	if response == nil {
+		return true
+	}
+
+	statusCode := response.StatusCode
+	return statusCode == 429 ||
+		statusCode == 500 ||
+		statusCode == 502 ||
+		statusCode == 503 ||
+		statusCode == 504
+}
+
+func (a *isInstancePowerAction) handleError(response *core.DetailedResponse, err error, operation string, resp *action.InvokeResponse) {
+	if response == nil {
+		resp.Diagnostics.AddError(
+			"Network Error",
+			fmt.Sprintf("Failed to connect to VPC API: %s", err.Error()),
+		)
+		return
+	}
+
+	statusCode := response.StatusCode
+	switch statusCode {
+	case 401:
+		resp.Diagnostics.AddError(
+			"Authentication Failed",
+			fmt.Sprintf("Authentication with IBM Cloud failed. Please verify your API key or credentials are valid and not expired. Error: %s", err.Error()),
+		)
+	case 403:
+		resp.Diagnostics.AddError(
+			"Authorization Failed",
+			fmt.Sprintf("You do not have permission to %s. Please verify you have the 'Operator' role or higher on the instance. Error: %s", operation, err.Error()),
+		)
+	case 404:
+		resp.Diagnostics.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("The specified instance was not found. Please verify the instance ID is correct. Error: %s", err.Error()),
+		)
+	case 409:
+		resp.Diagnostics.AddError(
+			"Conflict",
+			fmt.Sprintf("Unable to %s due to a conflict with another pending action. Use force to cancel queued actions. Error: %s", operation, err.Error()),
+		)
+	default:
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Failed to %s (HTTP %d): %s", operation, statusCode, err.Error()), "ibm_is_instance_power", "invoke")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag(path.Empty())...)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// TestAccIBMISInstancePowerActionBasic stops an instance from an after_create
+// trigger and verifies the instance is stopped once the action returns.
+func TestAccIBMISInstancePowerActionBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstancePowerActionConfig(vpcname, subnetname, sshname, publicKey, name, "stop"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_is_instance.testacc_instance", "id"),
+					testAccCheckIBMISInstancePowerActionStatus("ibm_is_instance.testacc_instance", "stopped"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccIBMISInstancePowerActionInvalidAction verifies that an unsupported
+// power action is rejected when the configuration is validated.
+func TestAccIBMISInstancePowerActionInvalidAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					action "ibm_is_instance_power" "test_action" {
+						config {
+							instance = "0000-00000000-0000-0000-0000-000000000000"
+							action   = "hibernate"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Power Action"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstancePowerActionStatus(n, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		getinsOptions := &vpcv1.GetInstanceOptions{
+			ID: &rs.Primary.ID,
+		}
+		instance, response, err := sess.GetInstance(getinsOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting instance: %s\n%s", err, response)
+		}
+		if *instance.Status != status {
+			return fmt.Errorf("Instance %s is %s, expected %s", rs.Primary.ID, *instance.Status, status)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISInstancePowerActionConfig(vpcname, subnetname, sshname, publicKey, name, powerAction string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	action "ibm_is_instance_power" "test_action" {
+		config {
+			instance = ibm_is_instance.testacc_instance.id
+			action   = "%s"
+		}
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_is_instance_power.test_action]
+			}
+		}
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, powerAction, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName)
+}
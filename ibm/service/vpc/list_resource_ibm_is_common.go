@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	_ list.ListResource                 = &isListResource{}
+	_ list.ListResourceWithConfigure    = &isListResource{}
+	_ list.ListResourceWithRawV6Schemas = &isListResource{}
+)
+
+// isListResource lists the VPC resources of a single type for `terraform
+// query`. The managed resources are SDKv2 resources, so their schemas are
+// passed to the framework through RawV6Schemas and the resource state of each
+// result is produced by the SDKv2 read function.
+type isListResource struct {
+	typeName    string
+	description string
+	resource    func() *sdkschema.Resource
+	// listPage returns one page of resources starting at start, together with
+	// the start token of the next page. The resource group is passed on for
+	// APIs that can filter on it; results are filtered again afterwards.
+	listPage func(ctx context.Context, client *vpcv1.VpcV1, resourceGroup, start string) ([]isListItem, string, error)
+
+	client  *vpcv1.VpcV1
+	session conns.ClientSession
+}
+
+// isListItem is the part of a listed VPC resource needed to filter it and to
+// build its list result.
+type isListItem struct {
+	ID              string
+	Name            string
+	CRN             string
+	ResourceGroupID string
+	// UserTags is only set for APIs returning user tags; the tags of other
+	// resources are looked up through Global Tagging when filtering on tags.
+	UserTags []string
+}
+
+type isListModel struct {
+	ResourceGroup types.String `tfsdk:"resource_group"`
+	NamePrefix    types.String `tfsdk:"name_prefix"`
+	Tags          types.List   `tfsdk:"tags"`
+}
+
+func (r *isListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+func (r *isListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: r.description,
+		Attributes: map[string]schema.Attribute{
+			"resource_group": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the resource group to list resources from.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list resources whose name starts with this prefix.",
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list resources that have all of these user tags.",
+			},
+		},
+	}
+}
+
+func (r *isListResource) RawV6Schemas(ctx context.Context, req list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	flex.ListResourceRawV6Schemas(ctx, r.resource(), resp)
+}
+
+func (r *isListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := vpcClient(session)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create VPC Client",
+			"An unexpected error occurred when creating the VPC client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"VPC Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	r.client = client
+	r.session = session
+}
+
+func (r *isListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config isListModel
+	diags := req.Config.Get(ctx, &config)
+	var tags []string
+	if !diags.HasError() && !config.Tags.IsNull() {
+		diags.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	resourceGroup := config.ResourceGroup.ValueString()
+	namePrefix := config.NamePrefix.ValueString()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		start := ""
+		for {
+			items, next, err := r.listPage(ctx, r.client, resourceGroup, start)
+			if err != nil {
+				result := req.NewListResult(ctx)
+				result.Diagnostics.AddError(
+					"Unable to List Resources",
+					fmt.Sprintf("Error listing %s resources: %s", r.typeName, err),
+				)
+				push(result)
+				return
+			}
+
+			for _, item := range items {
+				if !strings.HasPrefix(item.Name, namePrefix) || (resourceGroup != "" && item.ResourceGroupID != resourceGroup) {
+					continue
+				}
+
+				result := req.NewListResult(ctx)
+				if len(tags) > 0 {
+					matched, err := r.hasTags(item, tags)
+					if err != nil {
+						result.Diagnostics.AddError(
+							"Unable to Read Tags",
+							fmt.Sprintf("Error reading the tags of %s: %s", item.ID, err),
+						)
+						push(result)
+						return
+					}
+					if !matched {
+						continue
+					}
+				}
+
+				result.DisplayName = item.Name
+				result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), item.ID)...)
+				if req.IncludeResource && !result.Diagnostics.HasError() {
+					found, readDiags := flex.ListResourceFromRead(ctx, r.resource(), r.session, item.ID, &result)
+					result.Diagnostics.Append(readDiags...)
+					if !found && !readDiags.HasError() {
+						// Deleted after it was listed
+						continue
+					}
+				}
+
+				if !push(result) {
+					return
+				}
+				count++
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+			}
+
+			if next == "" {
+				return
+			}
+			start = next
+		}
+	}
+}
+
+// hasTags reports whether the item has all of the given user tags.
+func (r *isListResource) hasTags(item isListItem, tags []string) (bool, error) {
+	userTags := item.UserTags
+	if userTags == nil {
+		tagSet, err := flex.GetGlobalTagsUsingCRN(r.session, item.CRN, "", isUserTagType)
+		if err != nil {
+			return false, err
+		}
+		userTags = flex.ExpandStringList(tagSet.List())
+	}
+
+	for _, tag := range tags {
+		if !slices.Contains(userTags, tag) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// isListResourceGroupID returns the ID of a resource group reference, or an
+// empty string if the reference is missing.
+func isListResourceGroupID(resourceGroup *vpcv1.ResourceGroupReference) string {
+	if resourceGroup == nil || resourceGroup.ID == nil {
+		return ""
+	}
+	return *resourceGroup.ID
+}
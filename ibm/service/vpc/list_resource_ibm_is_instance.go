@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func NewISInstanceListResource() list.ListResource {
+	return &isListResource{
+		typeName:    "ibm_is_instance",
+		description: "Lists the virtual server instances in the region.",
+		resource:    ResourceIBMISInstance,
+		listPage:    listISInstances,
+	}
+}
+
+func listISInstances(ctx context.Context, client *vpcv1.VpcV1, resourceGroup, start string) ([]isListItem, string, error) {
+	options := &vpcv1.ListInstancesOptions{}
+	if resourceGroup != "" {
+		options.ResourceGroupID = &resourceGroup
+	}
+	if start != "" {
+		options.Start = &start
+	}
+
+	result, _, err := client.ListInstancesWithContext(ctx, options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	items := make([]isListItem, 0, len(result.Instances))
+	for _, instance := range result.Instances {
+		items = append(items, isListItem{
+			ID:              *instance.ID,
+			Name:            *instance.Name,
+			CRN:             *instance.CRN,
+			ResourceGroupID: isListResourceGroupID(instance.ResourceGroup),
+		})
+	}
+	return items, flex.GetNext(result.Next), nil
+}
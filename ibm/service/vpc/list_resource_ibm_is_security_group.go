@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func NewISSecurityGroupListResource() list.ListResource {
+	return &isListResource{
+		typeName:    "ibm_is_security_group",
+		description: "Lists the security groups in the region.",
+		resource:    ResourceIBMISSecurityGroup,
+		listPage:    listISSecurityGroups,
+	}
+}
+
+func listISSecurityGroups(ctx context.Context, client *vpcv1.VpcV1, resourceGroup, start string) ([]isListItem, string, error) {
+	options := &vpcv1.ListSecurityGroupsOptions{}
+	if resourceGroup != "" {
+		options.ResourceGroupID = &resourceGroup
+	}
+	if start != "" {
+		options.Start = &start
+	}
+
+	result, _, err := client.ListSecurityGroupsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	items := make([]isListItem, 0, len(result.SecurityGroups))
+	for _, securityGroup := range result.SecurityGroups {
+		items = append(items, isListItem{
+			ID:              *securityGroup.ID,
+			Name:            *securityGroup.Name,
+			CRN:             *securityGroup.CRN,
+			ResourceGroupID: isListResourceGroupID(securityGroup.ResourceGroup),
+		})
+	}
+	return items, flex.GetNext(result.Next), nil
+}
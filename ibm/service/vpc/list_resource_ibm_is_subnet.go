@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func NewISSubnetListResource() list.ListResource {
+	return &isListResource{
+		typeName:    "ibm_is_subnet",
+		description: "Lists the subnets in the region.",
+		resource:    ResourceIBMISSubnet,
+		listPage:    listISSubnets,
+	}
+}
+
+func listISSubnets(ctx context.Context, client *vpcv1.VpcV1, resourceGroup, start string) ([]isListItem, string, error) {
+	options := &vpcv1.ListSubnetsOptions{}
+	if resourceGroup != "" {
+		options.ResourceGroupID = &resourceGroup
+	}
+	if start != "" {
+		options.Start = &start
+	}
+
+	result, _, err := client.ListSubnetsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	items := make([]isListItem, 0, len(result.Subnets))
+	for _, subnet := range result.Subnets {
+		items = append(items, isListItem{
+			ID:              *subnet.ID,
+			Name:            *subnet.Name,
+			CRN:             *subnet.CRN,
+			ResourceGroupID: isListResourceGroupID(subnet.ResourceGroup),
+		})
+	}
+	return items, flex.GetNext(result.Next), nil
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func NewISVolumeListResource() list.ListResource {
+	return &isListResource{
+		typeName:    "ibm_is_volume",
+		description: "Lists the block storage volumes in the region.",
+		resource:    ResourceIBMISVolume,
+		listPage:    listISVolumes,
+	}
+}
+
+func listISVolumes(ctx context.Context, client *vpcv1.VpcV1, resourceGroup, start string) ([]isListItem, string, error) {
+	// The volumes API cannot filter on resource group, the common list
+	// implementation filters the results instead
+	options := &vpcv1.ListVolumesOptions{}
+	if start != "" {
+		options.Start = &start
+	}
+
+	result, _, err := client.ListVolumesWithContext(ctx, options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	items := make([]isListItem, 0, len(result.Volumes))
+	for _, volume := range result.Volumes {
+		items = append(items, isListItem{
+			ID:              *volume.ID,
+			Name:            *volume.Name,
+			CRN:             *volume.CRN,
+			ResourceGroupID: isListResourceGroupID(volume.ResourceGroup),
+			UserTags:        volume.UserTags,
+		})
+	}
+	return items, flex.GetNext(result.Next), nil
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func NewISVPCListResource() list.ListResource {
+	return &isListResource{
+		typeName:    "ibm_is_vpc",
+		description: "Lists the VPCs in the region.",
+		resource:    ResourceIBMISVPC,
+		listPage:    listISVPCs,
+	}
+}
+
+func listISVPCs(ctx context.Context, client *vpcv1.VpcV1, resourceGroup, start string) ([]isListItem, string, error) {
+	options := &vpcv1.ListVpcsOptions{}
+	if resourceGroup != "" {
+		options.ResourceGroupID = &resourceGroup
+	}
+	if start != "" {
+		options.Start = &start
+	}
+
+	result, _, err := client.ListVpcsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	items := make([]isListItem, 0, len(result.Vpcs))
+	for _, vpc := range result.Vpcs {
+		items = append(items, isListItem{
+			ID:              *vpc.ID,
+			Name:            *vpc.Name,
+			CRN:             *vpc.CRN,
+			ResourceGroupID: isListResourceGroupID(vpc.ResourceGroup),
+		})
+	}
+	return items, flex.GetNext(result.Next), nil
+}
@@ -46,7 +46,20 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 		UpdateContext: resourceIBMISFloatingIPUpdate,
 		DeleteContext: resourceIBMISFloatingIPDelete,
 		Exists:        resourceIBMISFloatingIPExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the floating IP.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -408,6 +421,9 @@ func resourceIBMISFloatingIPRead(context context.Context, d *schema.ResourceData
 		return err
 	}
 
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_floating_ip", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -142,6 +142,10 @@ func ResourceIBMISInstance() *schema.Resource {
 		Exists:        resourceIBMisInstanceExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) (result []*schema.ResourceData, err error) {
+				// Importing by identity leaves the ID empty, take it from the identity instead
+				if _, err = schema.ImportStatePassthroughWithIdentity("id")(context.Background(), d, meta); err != nil {
+					return nil, err
+				}
 				log.Printf("[INFO] Instance (%s) importing", d.Id())
 				id := d.Id()
 				instanceC, err := vpcClient(meta)
@@ -172,6 +176,17 @@ func ResourceIBMISInstance() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the virtual server instance.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -5514,6 +5529,9 @@ func resourceIBMisInstanceRead(context context.Context, d *schema.ResourceData,
 	if err != nil {
 		return err
 	}
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
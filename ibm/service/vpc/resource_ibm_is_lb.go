@@ -61,7 +61,20 @@ func ResourceIBMISLB() *schema.Resource {
 		UpdateContext: resourceIBMISLBUpdate,
 		DeleteContext: resourceIBMISLBDelete,
 		Exists:        resourceIBMISLBExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the load balancer.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -577,6 +590,9 @@ func resourceIBMISLBRead(context context.Context, d *schema.ResourceData, meta i
 		return err
 	}
 
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -49,7 +49,25 @@ func ResourceIBMISLBListener() *schema.Resource {
 		UpdateContext: resourceIBMISLBListenerUpdate,
 		DeleteContext: resourceIBMISLBListenerDelete,
 		Exists:        resourceIBMISLBListenerExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportStateWithIdentity("/", "lb", "listener_id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"lb": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the load balancer.",
+					},
+					"listener_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the load balancer listener.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -519,6 +537,9 @@ func resourceIBMISLBListenerRead(context context.Context, d *schema.ResourceData
 		return diagEerr
 	}
 
+	if err := flex.SetResourceIdentityFromID(d, "/", "lb", "listener_id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_listener", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -53,7 +53,25 @@ func ResourceIBMISLBPool() *schema.Resource {
 		UpdateContext: resourceIBMISLBPoolUpdate,
 		DeleteContext: resourceIBMISLBPoolDelete,
 		Exists:        resourceIBMISLBPoolExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportStateWithIdentity("/", "lb", "pool_id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"lb": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the load balancer.",
+					},
+					"pool_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the load balancer pool.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -447,6 +465,9 @@ func resourceIBMISLBPoolRead(context context.Context, d *schema.ResourceData, me
 		return diag
 	}
 
+	if err := flex.SetResourceIdentityFromID(d, "/", "lb", "pool_id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_pool", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -42,7 +42,30 @@ func ResourceIBMISLBPoolMember() *schema.Resource {
 		UpdateContext: resourceIBMISLBPoolMemberUpdate,
 		DeleteContext: resourceIBMISLBPoolMemberDelete,
 		Exists:        resourceIBMISLBPoolMemberExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportStateWithIdentity("/", "lb", "pool", "member_id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"lb": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the load balancer.",
+					},
+					"pool": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the load balancer pool.",
+					},
+					"member_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the load balancer pool member.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -320,6 +343,9 @@ func resourceIBMISLBPoolMemberRead(context context.Context, d *schema.ResourceDa
 		return diag
 	}
 
+	if err := flex.SetResourceIdentityFromID(d, "/", "lb", "pool", "member_id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_pool_member", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -58,7 +58,20 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 		UpdateContext: resourceIBMISNetworkACLUpdate,
 		DeleteContext: resourceIBMISNetworkACLDelete,
 		Exists:        resourceIBMISNetworkACLExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the network ACL.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -604,6 +617,9 @@ func resourceIBMISNetworkACLRead(context context.Context, d *schema.ResourceData
 	if err != nil {
 		return err
 	}
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_network_acl", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -33,7 +33,25 @@ func ResourceIBMISNetworkACLRule() *schema.Resource {
 		ReadContext:   resourceIBMISNetworkACLRuleRead,
 		UpdateContext: resourceIBMISNetworkACLRuleUpdate,
 		DeleteContext: resourceIBMISNetworkACLRuleDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportStateWithIdentity("/", "network_acl", "rule_id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"network_acl": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the network ACL.",
+					},
+					"rule_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the network ACL rule.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -739,6 +757,9 @@ func resourceIBMISNetworkACLRuleRead(context context.Context, d *schema.Resource
 	if diagErr != nil {
 		return diagErr
 	}
+	if err := flex.SetResourceIdentityFromID(d, "/", "network_acl", "rule_id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_network_acl_rule", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -46,7 +46,20 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 		UpdateContext: resourceIBMISPublicGatewayUpdate,
 		DeleteContext: resourceIBMISPublicGatewayDelete,
 		Exists:        resourceIBMISPublicGatewayExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the public gateway.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -420,6 +433,9 @@ func resourceIBMISPublicGatewayRead(context context.Context, d *schema.ResourceD
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_public_gateway", "read", "set-resource_group_name").GetDiag()
 		}
 	}
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_public_gateway", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
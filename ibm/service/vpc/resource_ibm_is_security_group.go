@@ -39,7 +39,20 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 		UpdateContext: resourceIBMISSecurityGroupUpdate,
 		DeleteContext: resourceIBMISSecurityGroupDelete,
 		Exists:        resourceIBMISSecurityGroupExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the security group.",
+					},
+				}
+			},
+		},
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
@@ -554,6 +567,9 @@ func resourceIBMISSecurityGroupRead(context context.Context, d *schema.ResourceD
 		err = fmt.Errorf("Error setting resource_crn: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "read", "set-resource_crn").GetDiag()
 	}
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
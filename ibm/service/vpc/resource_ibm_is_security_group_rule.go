@@ -47,7 +47,25 @@ func ResourceIBMISSecurityGroupRule() *schema.Resource {
 		UpdateContext: resourceIBMISSecurityGroupRuleUpdate,
 		DeleteContext: resourceIBMISSecurityGroupRuleDelete,
 		Exists:        resourceIBMISSecurityGroupRuleExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportStateWithIdentity(".", "group", "rule_id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"group": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the security group.",
+					},
+					"rule_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the security group rule.",
+					},
+				}
+			},
+		},
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
@@ -907,6 +925,9 @@ func resourceIBMISSecurityGroupRuleRead(context context.Context, d *schema.Resou
 			}
 		}
 	}
+	if err := flex.SetResourceIdentityFromID(d, ".", "group", "rule_id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rule", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
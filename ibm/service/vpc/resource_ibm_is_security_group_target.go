@@ -31,7 +31,25 @@ func ResourceIBMISSecurityGroupTarget() *schema.Resource {
 		ReadContext:   resourceIBMISSecurityGroupTargetRead,
 		DeleteContext: resourceIBMISSecurityGroupTargetDelete,
 		Exists:        resourceIBMISSecurityGroupTargetExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportStateWithIdentity("/", "security_group", "target"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"security_group": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the security group.",
+					},
+					"target": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the target.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -232,6 +250,9 @@ func resourceIBMISSecurityGroupTargetRead(context context.Context, d *schema.Res
 		}
 	}
 
+	if err := flex.SetResourceIdentityFromID(d, "/", "security_group", "target"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_target", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -58,7 +58,20 @@ func ResourceIBMISSubnet() *schema.Resource {
 		UpdateContext: resourceIBMISSubnetUpdate,
 		DeleteContext: resourceIBMISSubnetDelete,
 		Exists:        resourceIBMISSubnetExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the subnet.",
+					},
+				}
+			},
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -469,6 +482,9 @@ func resourceIBMISSubnetRead(context context.Context, d *schema.ResourceData, me
 	if err != nil {
 		return err
 	}
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
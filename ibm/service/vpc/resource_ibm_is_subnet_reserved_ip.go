@@ -35,7 +35,25 @@ func ResourceIBMISReservedIP() *schema.Resource {
 		UpdateContext: resourceIBMISReservedIPUpdate,
 		DeleteContext: resourceIBMISReservedIPDelete,
 		Exists:        resourceIBMISReservedIPExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportStateWithIdentity("/", "subnet", "reserved_ip"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"subnet": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the subnet.",
+					},
+					"reserved_ip": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the reserved IP.",
+					},
+				}
+			},
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -328,6 +346,9 @@ func resourceIBMISReservedIPRead(context context.Context, d *schema.ResourceData
 			}
 		}
 	}
+	if err := flex.SetResourceIdentityFromID(d, "/", "subnet", "reserved_ip"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet_reserved_ip", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
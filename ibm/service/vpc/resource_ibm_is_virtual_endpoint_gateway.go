@@ -58,7 +58,20 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 		UpdateContext: resourceIBMisVirtualEndpointGatewayUpdate,
 		DeleteContext: resourceIBMisVirtualEndpointGatewayDelete,
 		Exists:        resourceIBMisVirtualEndpointGatewayExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the endpoint gateway.",
+					},
+				}
+			},
+		},
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
@@ -690,6 +703,9 @@ func resourceIBMisVirtualEndpointGatewayRead(context context.Context, d *schema.
 	}
 	d.Set(isVirtualEndpointGatewayAccessTags, accesstags)
 
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_virtual_endpoint_gateway", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
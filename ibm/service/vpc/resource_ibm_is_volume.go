@@ -66,7 +66,20 @@ func ResourceIBMISVolume() *schema.Resource {
 		UpdateContext: resourceIBMISVolumeUpdate,
 		DeleteContext: resourceIBMISVolumeDelete,
 		Exists:        resourceIBMISVolumeExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the volume.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -659,6 +672,9 @@ func resourceIBMISVolumeRead(context context.Context, d *schema.ResourceData, me
 	if err != nil {
 		return err
 	}
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_volume", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
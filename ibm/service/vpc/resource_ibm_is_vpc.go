@@ -99,7 +99,20 @@ func ResourceIBMISVPC() *schema.Resource {
 		UpdateContext: resourceIBMISVPCUpdate,
 		DeleteContext: resourceIBMISVPCDelete,
 		Exists:        resourceIBMISVPCExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the VPC.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -998,6 +1011,9 @@ func resourceIBMISVPCRead(context context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return err
 	}
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
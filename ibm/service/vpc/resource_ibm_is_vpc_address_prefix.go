@@ -34,7 +34,25 @@ func ResourceIBMISVpcAddressPrefix() *schema.Resource {
 		UpdateContext: resourceIBMISVpcAddressPrefixUpdate,
 		DeleteContext: resourceIBMISVpcAddressPrefixDelete,
 		Exists:        resourceIBMISVpcAddressPrefixExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportStateWithIdentity("/", "vpc", "address_prefix"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"vpc": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the VPC.",
+					},
+					"address_prefix": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the address prefix.",
+					},
+				}
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			isVPCAddressPrefixPrefixName: {
@@ -181,6 +199,9 @@ func resourceIBMISVpcAddressPrefixRead(context context.Context, d *schema.Resour
 		return error
 	}
 
+	if err := flex.SetResourceIdentityFromID(d, "/", "vpc", "address_prefix"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -56,7 +56,25 @@ func ResourceIBMISVPCRoutingTable() *schema.Resource {
 		UpdateContext: resourceIBMISVPCRoutingTableUpdate,
 		DeleteContext: resourceIBMISVPCRoutingTableDelete,
 		Exists:        resourceIBMISVPCRoutingTableExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportStateWithIdentity("/", "vpc", "routing_table"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"vpc": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the VPC.",
+					},
+					"routing_table": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the routing table.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -495,6 +513,9 @@ func resourceIBMISVPCRoutingTableRead(context context.Context, d *schema.Resourc
 	if err = d.Set(rtAccessTags, accesstags); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting access_tags: %s", err), "ibm_is_vpc_routing_table", "read", "set-access_tags").GetDiag()
 	}
+	if err := flex.SetResourceIdentityFromID(d, "/", "vpc", "routing_table"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -35,7 +35,30 @@ func ResourceIBMISVPCRoutingTableRoute() *schema.Resource {
 		UpdateContext: resourceIBMISVPCRoutingTableRouteUpdate,
 		DeleteContext: resourceIBMISVPCRoutingTableRouteDelete,
 		Exists:        resourceIBMISVPCRoutingTableRouteExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportStateWithIdentity("/", "vpc", "routing_table", "route_id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"vpc": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the VPC.",
+					},
+					"routing_table": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the routing table.",
+					},
+					"route_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the route.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -362,6 +385,9 @@ func resourceIBMISVPCRoutingTableRouteRead(context context.Context, d *schema.Re
 	if err = d.Set("priority", route.Priority); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting priority: %s", err), "ibm_is_vpc_routing_table_route", "read", "set-priority").GetDiag()
 	}
+	if err := flex.SetResourceIdentityFromID(d, "/", "vpc", "routing_table", "route_id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table_route", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
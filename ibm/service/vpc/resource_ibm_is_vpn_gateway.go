@@ -54,7 +54,20 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 		UpdateContext: resourceIBMISVPNGatewayUpdate,
 		DeleteContext: resourceIBMISVPNGatewayDelete,
 		Exists:        resourceIBMISVPNGatewayExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the VPN gateway.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -523,6 +536,9 @@ func resourceIBMISVPNGatewayRead(context context.Context, d *schema.ResourceData
 	if err != nil {
 		return err
 	}
+	if err := flex.SetResourceIdentityFromID(d, "", "id"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpn_gateway", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
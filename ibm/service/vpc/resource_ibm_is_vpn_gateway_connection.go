@@ -56,6 +56,22 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceIBMISVPNGatewayConnectionImport,
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"vpn_gateway": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the VPN gateway.",
+					},
+					"gateway_connection": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "The unique identifier of the VPN gateway connection.",
+					},
+				}
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -851,6 +867,9 @@ func resourceIBMISVPNGatewayConnectionRead(context context.Context, d *schema.Re
 	if diagErr != nil {
 		return diagErr
 	}
+	if err := flex.SetResourceIdentityFromID(d, "/", "vpn_gateway", "gateway_connection"); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpn_gateway_connection", "read", "set-identity").GetDiag()
+	}
 	return nil
 }
 
@@ -899,6 +918,9 @@ func vpngwconGet(context context.Context, d *schema.ResourceData, meta interface
 }
 
 func resourceIBMISVPNGatewayConnectionImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := flex.SetIDFromIdentity(d, "/", isVPNGatewayConnectionVPNGateway, isVPNGatewayConnection); err != nil {
+		return nil, err
+	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
 		return nil, err
@@ -0,0 +1,416 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package unittest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+//
+// Record and replay of the HTTP calls made by the IBM Cloud clients, so that
+// resource and data source tests can run without an account. Set the recorder
+// as the transport of the provider:
+//
+//	recorder := unittest.NewRecorder(t, "test-fixtures/cassettes/is_vpc_basic.json")
+//	resource.Test(t, resource.TestCase{
+//		Providers: acc.TestAccProvidersWithHTTPTransport(recorder),
+//		...
+//	})
+//
+// acc.TestAccProtoV6ProviderFactoriesWithHTTPTransport does the same for the
+// framework resources, and provider.ProviderWithHTTPTransport returns a single
+// provider.
+//
+// Run the test once with IBMCLOUD_RECORDER_MODE=record against a live account
+// to write the cassette; later runs replay it. Credentials are scrubbed from
+// the cassette before it is written, and tokens are replaced by unsigned JWTs
+// that keep the account and user claims.
+//
+
+// RecorderModeEnv is the environment variable selecting the RecorderMode.
+const RecorderModeEnv = "IBMCLOUD_RECORDER_MODE"
+
+// RecorderMode selects what a Recorder does with the requests it receives.
+type RecorderMode string
+
+const (
+	// RecorderModeReplay answers requests from the cassette. It is the default.
+	RecorderModeReplay RecorderMode = "replay"
+	// RecorderModeRecord sends requests to the API and saves the interactions
+	// to the cassette when the test ends.
+	RecorderModeRecord RecorderMode = "record"
+	// RecorderModePassthrough sends requests to the API without recording.
+	RecorderModePassthrough RecorderMode = "passthrough"
+)
+
+// Redacted replaces the scrubbed values in a cassette.
+const Redacted = "REDACTED"
+
+// scrubbedHeaders are the headers whose values are never written to a cassette.
+var scrubbedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Refresh-Token",
+	"Set-Cookie",
+	"X-Api-Key",
+	"X-Auth-Refresh-Token",
+	"X-Auth-Token",
+	"X-Auth-User-Token",
+	"X-Auth-Uaa-Token",
+}
+
+// scrubbedFields are the JSON fields, form fields and query parameters whose
+// values are never written to a cassette.
+var scrubbedFields = map[string]bool{
+	"access_token":            true,
+	"api_key":                 true,
+	"apikey":                  true,
+	"client_secret":           true,
+	"delegated_refresh_token": true,
+	"password":                true,
+	"passcode":                true,
+	"refresh_token":           true,
+	"secret_access_key":       true,
+	"token":                   true,
+	"uaa_refresh_token":       true,
+	"uaa_token":               true,
+}
+
+// scrubbedTokens are the scrubbed fields holding tokens. They are replaced by
+// a dummy JWT rather than Redacted, so that the provider can still read the
+// claims of the token on replay.
+var scrubbedTokens = map[string]bool{
+	"access_token":            true,
+	"delegated_refresh_token": true,
+	"refresh_token":           true,
+	"token":                   true,
+	"uaa_refresh_token":       true,
+	"uaa_token":               true,
+}
+
+// tokenClaims are the claims of a recorded token kept in its dummy JWT, which
+// identify the account and the user without any personal information.
+var tokenClaims = []string{"account", "exp", "iam_id", "iat", "id", "identifier", "iss", "realmid", "sub", "sub_type"}
+
+// defaultTokenClaims are the claims of the dummy JWT of a token that is not
+// a JWT.
+var defaultTokenClaims = map[string]interface{}{
+	"account": map[string]interface{}{"bss": "00000000000000000000000000000000"},
+	"iam_id":  "IBMid-000000000",
+	"id":      "IBMid-000000000",
+	"iss":     "https://iam.cloud.ibm.com/identity",
+	"sub":     "IBMid-000000000",
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request saved in a cassette.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is the part of a response saved in a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper that records the interactions of a test
+// to a cassette file, or replays them from it.
+type Recorder struct {
+	// Transport sends the requests in record and passthrough modes. It
+	// defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	mode     RecorderMode
+	cassette string
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     []bool
+}
+
+// NewRecorder returns a Recorder for the given cassette file, in the mode set
+// by IBMCLOUD_RECORDER_MODE. In replay mode the test fails if the cassette
+// cannot be loaded; in record mode the cassette is written when the test ends.
+func NewRecorder(t testing.TB, cassette string) *Recorder {
+	t.Helper()
+
+	mode := RecorderMode(strings.ToLower(os.Getenv(RecorderModeEnv)))
+	if mode == "" {
+		mode = RecorderModeReplay
+	}
+	r, err := NewRecorderWithMode(cassette, mode)
+	if err != nil {
+		t.Fatalf("Error creating the HTTP recorder: %s", err)
+	}
+	if mode == RecorderModeRecord {
+		t.Cleanup(func() {
+			if err := r.Save(); err != nil {
+				t.Errorf("Error saving cassette %s: %s", cassette, err)
+			}
+		})
+	}
+	return r
+}
+
+// NewRecorderWithMode returns a Recorder for the given cassette file in the
+// given mode. In replay mode the cassette is loaded immediately.
+func NewRecorderWithMode(cassette string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{
+		mode:     mode,
+		cassette: cassette,
+	}
+	switch mode {
+	case RecorderModeReplay:
+		data, err := os.ReadFile(cassette)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading cassette: %s", err)
+		}
+		if err = json.Unmarshal(data, &r.interactions); err != nil {
+			return nil, fmt.Errorf("Error decoding cassette %s: %s", cassette, err)
+		}
+		r.replayed = make([]bool, len(r.interactions))
+	case RecorderModeRecord, RecorderModePassthrough:
+	default:
+		return nil, fmt.Errorf("Invalid %s %q, expected one of %s, %s or %s", RecorderModeEnv, mode, RecorderModeReplay, RecorderModeRecord, RecorderModePassthrough)
+	}
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch r.mode {
+	case RecorderModeReplay:
+		return r.replay(req)
+	case RecorderModeRecord:
+		return r.record(req)
+	default:
+		return r.transport().RoundTrip(req)
+	}
+}
+
+// Save writes the recorded interactions to the cassette file.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(r.cassette), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.cassette, append(data, '\n'), 0644)
+}
+
+func (r *Recorder) transport() http.RoundTripper {
+	if r.Transport != nil {
+		return r.Transport
+	}
+	return http.DefaultTransport
+}
+
+// replay answers the request with the first interaction of the cassette that
+// has not been replayed yet and has the same method and URL.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		// Drain the body so that the client sees the request as sent
+		io.Copy(io.Discard, req.Body)
+		req.Body.Close()
+	}
+	requestURL := scrubURL(req.URL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.interactions {
+		if r.replayed[i] || interaction.Request.Method != req.Method || interaction.Request.URL != requestURL {
+			continue
+		}
+		r.replayed[i] = true
+		header := interaction.Response.Headers.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		// The recorded length no longer holds once the body has been scrubbed
+		header.Del("Content-Length")
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("No interaction left in cassette %s for %s %s", r.cassette, req.Method, requestURL)
+}
+
+// record sends the request and keeps a scrubbed copy of the interaction.
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		if requestBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	resp, err := r.transport().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := &Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     scrubURL(req.URL),
+			Headers: scrubHeaders(req.Header),
+			Body:    scrubBody(req.Header.Get("Content-Type"), requestBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    scrubHeaders(resp.Header),
+			Body:       scrubBody(resp.Header.Get("Content-Type"), responseBody),
+		},
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+func scrubHeaders(headers http.Header) http.Header {
+	scrubbed := headers.Clone()
+	for _, header := range scrubbedHeaders {
+		if scrubbed.Get(header) != "" {
+			scrubbed.Set(header, Redacted)
+		}
+	}
+	return scrubbed
+}
+
+func scrubURL(u *url.URL) string {
+	scrubbed := *u
+	scrubbed.User = nil
+	if scrubbed.RawQuery != "" {
+		scrubbed.RawQuery = scrubValues(scrubbed.Query()).Encode()
+	}
+	return scrubbed.String()
+}
+
+func scrubValues(values url.Values) url.Values {
+	for key := range values {
+		if scrubbedFields[strings.ToLower(key)] {
+			values.Set(key, scrubValue(key, values.Get(key)))
+		}
+	}
+	return values
+}
+
+// scrubValue returns the replacement of the value of a scrubbed field.
+func scrubValue(key, value string) string {
+	if scrubbedTokens[strings.ToLower(key)] {
+		return scrubToken(value)
+	}
+	return Redacted
+}
+
+// scrubToken returns an unsigned JWT with the tokenClaims of the token, or the
+// defaultTokenClaims if the token is not a JWT.
+func scrubToken(token string) string {
+	claims := map[string]interface{}{}
+	if parts := strings.Split(token, "."); len(parts) == 3 {
+		var recorded map[string]interface{}
+		if payload, err := base64.RawURLEncoding.DecodeString(parts[1]); err == nil && json.Unmarshal(payload, &recorded) == nil {
+			for _, claim := range tokenClaims {
+				if value, ok := recorded[claim]; ok {
+					claims[claim] = value
+				}
+			}
+		}
+	}
+	if len(claims) == 0 {
+		claims = defaultTokenClaims
+	}
+	payload, _ := json.Marshal(claims)
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + encode(payload) + "." + encode([]byte(Redacted))
+}
+
+// scrubBody scrubs JSON and form encoded bodies. Other bodies are saved as is.
+func scrubBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return scrubValues(values).Encode()
+		}
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if decoder.Decode(&value) == nil {
+		if scrubbed, err := json.Marshal(scrubJSON(value)); err == nil {
+			return string(scrubbed)
+		}
+	}
+	return string(body)
+}
+
+func scrubJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if value, ok := field.(string); ok && scrubbedFields[strings.ToLower(key)] {
+				v[key] = scrubValue(key, value)
+			} else {
+				v[key] = scrubJSON(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = scrubJSON(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,135 @@
+package unittest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRecorderServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/identity/token":
+			w.Write([]byte(`{"access_token":"secret-token","expires_in":3600}`))
+		default:
+			w.Write([]byte(`{"id":"r006-vpc","name":"` + r.Method + `"}`))
+		}
+	}))
+}
+
+func testRecorderRequest(t *testing.T, client *http.Client, method, url, contentType, body string) string {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret-token")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	data, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	server := testRecorderServer()
+	defer server.Close()
+	cassette := filepath.Join(t.TempDir(), "cassettes", "vpc.json")
+
+	recorder, err := NewRecorderWithMode(cassette, RecorderModeRecord)
+	assert.NoError(t, err)
+	client := &http.Client{Transport: recorder}
+	token := testRecorderRequest(t, client, http.MethodPost, server.URL+"/identity/token", "application/x-www-form-urlencoded", "grant_type=urn&apikey=secret-key")
+	assert.Contains(t, token, "secret-token")
+	assert.Contains(t, testRecorderRequest(t, client, http.MethodGet, server.URL+"/v1/vpcs/r006-vpc?version=2025-01-01", "", ""), "GET")
+	assert.Contains(t, testRecorderRequest(t, client, http.MethodDelete, server.URL+"/v1/vpcs/r006-vpc?version=2025-01-01", "", ""), "DELETE")
+	assert.NoError(t, recorder.Save())
+
+	data, err := os.ReadFile(cassette)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), "secret-key")
+
+	server.Close()
+	replayer, err := NewRecorderWithMode(cassette, RecorderModeReplay)
+	assert.NoError(t, err)
+	client = &http.Client{Transport: replayer}
+	var replayed struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(testRecorderRequest(t, client, http.MethodPost, server.URL+"/identity/token", "application/x-www-form-urlencoded", "grant_type=urn&apikey=other-key")), &replayed))
+	assert.Equal(t, scrubToken("secret-token"), replayed.AccessToken)
+	assert.Equal(t, 3600, replayed.ExpiresIn)
+	assert.Contains(t, testRecorderRequest(t, client, http.MethodDelete, server.URL+"/v1/vpcs/r006-vpc?version=2025-01-01", "", ""), "DELETE")
+	assert.Contains(t, testRecorderRequest(t, client, http.MethodGet, server.URL+"/v1/vpcs/r006-vpc?version=2025-01-01", "", ""), "GET")
+
+	_, err = client.Get(server.URL + "/v1/vpcs/r006-vpc?version=2025-01-01")
+	assert.Error(t, err)
+}
+
+func TestRecorderMissingCassette(t *testing.T) {
+	_, err := NewRecorderWithMode(filepath.Join(t.TempDir(), "missing.json"), RecorderModeReplay)
+	assert.Error(t, err)
+}
+
+func TestRecorderInvalidMode(t *testing.T) {
+	_, err := NewRecorderWithMode("vpc.json", RecorderMode("rewind"))
+	assert.Error(t, err)
+}
+
+func TestScrubBody(t *testing.T) {
+	assert.Equal(t, `{"items":[{"apikey":"REDACTED","id":12345678901234567890}]}`, scrubBody("application/json", []byte(`{"items":[{"apikey":"key","id":12345678901234567890}]}`)))
+	assert.Equal(t, "apikey=REDACTED&grant_type=urn", scrubBody("application/x-www-form-urlencoded", []byte("grant_type=urn&apikey=key")))
+	assert.Equal(t, "plain text", scrubBody("text/plain", []byte("plain text")))
+}
+
+func testRecorderJWT(claims string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"RS256","typ":"JWT"}`)) + "." + encode([]byte(claims)) + "." + encode([]byte("signature"))
+}
+
+func TestScrubToken(t *testing.T) {
+	recorded := testRecorderJWT(`{"account":{"bss":"4ea1882a2d3401ed1e459979941966ea"},"email":"user@example.com","exp":1735693200,"id":"IBMid-123","iss":"https://iam.cloud.ibm.com/identity","name":"User"}`)
+	scrubbed := scrubToken(recorded)
+	assert.NotContains(t, scrubbed, strings.Split(recorded, ".")[1])
+
+	// The provider parses the claims of the token without verifying it
+	token, err := jwt.Parse(scrubbed, func(token *jwt.Token) (interface{}, error) {
+		return "", nil
+	})
+	require.ErrorIs(t, err, jwt.ErrInvalidKeyType)
+	claims := token.Claims.(jwt.MapClaims)
+	assert.Equal(t, "IBMid-123", claims["id"])
+	assert.Equal(t, "4ea1882a2d3401ed1e459979941966ea", claims["account"].(map[string]interface{})["bss"])
+	assert.Equal(t, "https://iam.cloud.ibm.com/identity", claims["iss"])
+	assert.NotContains(t, claims, "email")
+	assert.NotContains(t, claims, "name")
+
+	token, _ = jwt.Parse(scrubToken("opaque-refresh-token"), func(token *jwt.Token) (interface{}, error) {
+		return "", nil
+	})
+	require.NotNil(t, token)
+	assert.Equal(t, "IBMid-000000000", token.Claims.(jwt.MapClaims)["id"])
+}
+
+func TestScrubBodyTokens(t *testing.T) {
+	body := scrubBody("application/json", []byte(`{"access_token":"secret-token","refresh_token":"secret-refresh","apikey":"key"}`))
+	assert.NotContains(t, body, "secret-")
+	assert.Contains(t, body, `"apikey":"REDACTED"`)
+	assert.Contains(t, body, `"access_token":"`+scrubToken("secret-token")+`"`)
+}
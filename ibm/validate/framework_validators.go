@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Validators of the ValidatorDict for terraform-plugin-framework resources.
+// They run the same SchemaValidateFunc as InvokeValidator, so a ValidateSchema
+// reports the same messages in SDKv2 and framework resources:
+//
+//	"name": schema.StringAttribute{
+//		Required:   true,
+//		Validators: []validator.String{validate.InvokeStringValidator("ibm_is_vpc", "name")},
+//	},
+
+// InvokeStringValidator returns the framework validator of a string attribute
+// of a resource. Like InvokeValidator, it does nothing if the resource or the
+// attribute has no ValidateSchema.
+func InvokeStringValidator(resourceName, identifier string) validator.String {
+	return frameworkValidator(lookupValidateSchema(validatorDict.ResourceValidatorDictionary, resourceName, identifier))
+}
+
+// InvokeInt64Validator returns the framework validator of an int64 attribute
+// of a resource.
+func InvokeInt64Validator(resourceName, identifier string) validator.Int64 {
+	return frameworkValidator(lookupValidateSchema(validatorDict.ResourceValidatorDictionary, resourceName, identifier))
+}
+
+// InvokeDataSourceStringValidator returns the framework validator of a string
+// attribute of a data source.
+func InvokeDataSourceStringValidator(dataSourceName, identifier string) validator.String {
+	return frameworkValidator(lookupValidateSchema(validatorDict.DataSourceValidatorDictionary, dataSourceName, identifier))
+}
+
+// InvokeDataSourceInt64Validator returns the framework validator of an int64
+// attribute of a data source.
+func InvokeDataSourceInt64Validator(dataSourceName, identifier string) validator.Int64 {
+	return frameworkValidator(lookupValidateSchema(validatorDict.DataSourceValidatorDictionary, dataSourceName, identifier))
+}
+
+// StringValidator returns the framework validator of the ValidateSchema.
+func (vs ValidateSchema) StringValidator() validator.String {
+	return frameworkValidator(&vs)
+}
+
+// Int64Validator returns the framework validator of the ValidateSchema.
+func (vs ValidateSchema) Int64Validator() validator.Int64 {
+	return frameworkValidator(&vs)
+}
+
+func lookupValidateSchema(dictionary map[string]*ResourceValidator, resourceName, identifier string) *ValidateSchema {
+	resourceItem, ok := dictionary[resourceName]
+	if !ok || resourceItem == nil {
+		return nil
+	}
+	for i := range resourceItem.Schema {
+		if resourceItem.Schema[i].Identifier == identifier {
+			return &resourceItem.Schema[i]
+		}
+	}
+	return nil
+}
+
+// schemaValidator adapts the SchemaValidateFunc of a ValidateSchema to the
+// validator interfaces of terraform-plugin-framework.
+type schemaValidator struct {
+	schema       *ValidateSchema
+	validateFunc schema.SchemaValidateFunc
+}
+
+var (
+	_ validator.String = schemaValidator{}
+	_ validator.Int64  = schemaValidator{}
+)
+
+func frameworkValidator(vs *ValidateSchema) schemaValidator {
+	if vs == nil {
+		return schemaValidator{}
+	}
+	return schemaValidator{
+		schema:       vs,
+		validateFunc: invokeValidatorInternal(*vs),
+	}
+}
+
+func (v schemaValidator) Description(ctx context.Context) string {
+	if v.schema == nil {
+		return ""
+	}
+	vs := v.schema
+	switch vs.ValidateFunctionIdentifier {
+	case IntBetween:
+		return fmt.Sprintf("value must be between %s and %s", vs.MinValue, vs.MaxValue)
+	case IntAtLeast:
+		return fmt.Sprintf("value must be at least %s", vs.MinValue)
+	case IntAtMost:
+		return fmt.Sprintf("value must be at most %s", vs.MaxValue)
+	case ValidateAllowedStringValue, ValidateAllowedICDPlanValue, ValidateAllowedIntValue:
+		return fmt.Sprintf("value must be one of: %s", strings.Join(strings.Split(vs.AllowedValues, ","), ", "))
+	case StringLenBetween:
+		return fmt.Sprintf("value length must be between %d and %d", vs.MinValueLength, vs.MaxValueLength)
+	case ValidateRegexpLen:
+		return fmt.Sprintf("value must match the regular expression %s and have a length between %d and %d", vs.Regexp, vs.MinValueLength, vs.MaxValueLength)
+	case ValidateRegexp:
+		return fmt.Sprintf("value must match the regular expression %s", vs.Regexp)
+	case ValidateIPorCIDR:
+		return "value must be an IP address or a CIDR"
+	case ValidateCIDRAddress, ValidateOverlappingAddress:
+		return "value must be a CIDR"
+	case ValidateNoZeroValues:
+		return "value must not be empty or zero"
+	case ValidateJSONString:
+		return "value must be a JSON string"
+	case ValidateBindedPackageName:
+		return "value must be a package name, such as /whisk.system/cloudant"
+	}
+	return ""
+}
+
+func (v schemaValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v schemaValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(v.validate(req.Path, req.ConfigValue.ValueString())...)
+}
+
+func (v schemaValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	// The SchemaValidateFuncs of TypeInt attributes expect an int
+	resp.Diagnostics.Append(v.validate(req.Path, int(req.ConfigValue.ValueInt64()))...)
+}
+
+// validate runs the SchemaValidateFunc and reports its warnings and errors the
+// way SDKv2 does: as diagnostics of the attribute, with the message as summary.
+func (v schemaValidator) validate(attributePath path.Path, value interface{}) (diags diag.Diagnostics) {
+	if v.validateFunc == nil {
+		return
+	}
+	warnings, errs := v.validateFunc(value, attributePath.String())
+	for _, warning := range warnings {
+		diags.AddAttributeWarning(attributePath, warning, "")
+	}
+	for _, err := range errs {
+		diags.AddAttributeError(attributePath, err.Error(), "")
+	}
+	return
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testValidatorDict() ValidatorDict {
+	return ValidatorDict{
+		ResourceValidatorDictionary: map[string]*ResourceValidator{
+			"ibm_test_resource": {
+				ResourceName: "ibm_test_resource",
+				Schema: []ValidateSchema{
+					{
+						Identifier:                 "name",
+						ValidateFunctionIdentifier: ValidateRegexpLen,
+						Type:                       TypeString,
+						Required:                   true,
+						Regexp:                     `^[a-z][-a-z0-9]*$`,
+						MinValueLength:             1,
+						MaxValueLength:             10,
+					},
+					{
+						Identifier:                 "profile",
+						ValidateFunctionIdentifier: ValidateAllowedStringValue,
+						Type:                       TypeString,
+						Optional:                   true,
+						AllowedValues:              "small, large",
+					},
+					{
+						Identifier:                 "capacity",
+						ValidateFunctionIdentifier: IntBetween,
+						Type:                       TypeInt,
+						Optional:                   true,
+						MinValue:                   "10",
+						MaxValue:                   "100",
+					},
+				},
+			},
+		},
+		DataSourceValidatorDictionary: map[string]*ResourceValidator{
+			"ibm_test_data_source": {
+				ResourceName: "ibm_test_data_source",
+				Schema: []ValidateSchema{
+					{
+						Identifier:                 "limit",
+						ValidateFunctionIdentifier: ValidateAllowedIntValue,
+						Type:                       TypeInt,
+						Optional:                   true,
+						AllowedValues:              "10, 50",
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateString(v validator.String, attribute string, value types.String) (summaries []string) {
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root(attribute), ConfigValue: value}, resp)
+	for _, d := range resp.Diagnostics {
+		summaries = append(summaries, d.Summary())
+	}
+	return
+}
+
+func validateInt64(v validator.Int64, attribute string, value types.Int64) (summaries []string) {
+	resp := &validator.Int64Response{}
+	v.ValidateInt64(context.Background(), validator.Int64Request{Path: path.Root(attribute), ConfigValue: value}, resp)
+	for _, d := range resp.Diagnostics {
+		summaries = append(summaries, d.Summary())
+	}
+	return
+}
+
+// sdkMessages returns the messages of the SchemaValidateFunc of InvokeValidator.
+func sdkMessages(resourceName, identifier string, value interface{}) (messages []string) {
+	warnings, errs := InvokeValidator(resourceName, identifier)(value, identifier)
+	messages = append(messages, warnings...)
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return
+}
+
+func TestFrameworkValidatorsMatchSDKValidators(t *testing.T) {
+	SetValidatorDict(testValidatorDict())
+
+	for _, test := range []struct {
+		identifier string
+		value      string
+		valid      bool
+	}{
+		{"name", "vpc-1", true},
+		{"name", "Vpc-1", false},
+		{"name", "vpc-1234567890", false},
+		{"profile", "small", true},
+		{"profile", "medium", false},
+	} {
+		expected := sdkMessages("ibm_test_resource", test.identifier, test.value)
+		summaries := validateString(InvokeStringValidator("ibm_test_resource", test.identifier), test.identifier, types.StringValue(test.value))
+		if test.valid != (len(summaries) == 0) {
+			t.Errorf("Expected %s %q to be valid: %t, got %v", test.identifier, test.value, test.valid, summaries)
+		}
+		if len(summaries) != len(expected) || (len(expected) > 0 && summaries[0] != expected[0]) {
+			t.Errorf("Expected messages %v for %s %q, got %v", expected, test.identifier, test.value, summaries)
+		}
+	}
+
+	for _, test := range []struct {
+		value int
+		valid bool
+	}{
+		{10, true},
+		{101, false},
+	} {
+		expected := sdkMessages("ibm_test_resource", "capacity", test.value)
+		summaries := validateInt64(InvokeInt64Validator("ibm_test_resource", "capacity"), "capacity", types.Int64Value(int64(test.value)))
+		if test.valid != (len(summaries) == 0) {
+			t.Errorf("Expected capacity %d to be valid: %t, got %v", test.value, test.valid, summaries)
+		}
+		if len(summaries) != len(expected) || (len(expected) > 0 && summaries[0] != expected[0]) {
+			t.Errorf("Expected messages %v for capacity %d, got %v", expected, test.value, summaries)
+		}
+	}
+
+	if summaries := validateInt64(InvokeDataSourceInt64Validator("ibm_test_data_source", "limit"), "limit", types.Int64Value(20)); len(summaries) != 1 {
+		t.Errorf("Expected limit 20 to be invalid, got %v", summaries)
+	}
+}
+
+func TestFrameworkValidatorsSkipUnsetValues(t *testing.T) {
+	SetValidatorDict(testValidatorDict())
+
+	v := InvokeStringValidator("ibm_test_resource", "name")
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown()} {
+		if summaries := validateString(v, "name", value); len(summaries) != 0 {
+			t.Errorf("Expected %s not to be validated, got %v", value, summaries)
+		}
+	}
+}
+
+func TestFrameworkValidatorsWithoutValidateSchema(t *testing.T) {
+	SetValidatorDict(testValidatorDict())
+
+	for _, v := range []validator.String{
+		InvokeStringValidator("ibm_test_resource", "description"),
+		InvokeStringValidator("ibm_missing_resource", "name"),
+		InvokeDataSourceStringValidator("ibm_missing_data_source", "name"),
+	} {
+		if summaries := validateString(v, "name", types.StringValue("Anything")); len(summaries) != 0 {
+			t.Errorf("Expected no validation, got %v", summaries)
+		}
+		if description := v.Description(context.Background()); description != "" {
+			t.Errorf("Expected no description, got %q", description)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider_framework"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/eventstreams"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -21,7 +22,7 @@ func main() {
 	// Upgrade the SDKv2 provider to protocol version 6
 	upgradedSdkProvider, err := tf5to6server.UpgradeServer(
 		ctx,
-		provider.Provider().GRPCProvider,
+		provider.GRPCProviderWithPlanWarnings(provider.Provider()),
 	)
 	if err != nil {
 		log.Fatal(err)
@@ -47,6 +48,8 @@ func main() {
 		"registry.terraform.io/IBM-Cloud/ibm",
 		muxServer.ProviderServer,
 	)
+	// Close the Kafka connections of the Event Streams resources
+	eventstreams.CloseClientPool()
 	if err != nil {
 		log.Fatal(err)
 	}